@@ -1,49 +1,519 @@
 package gopubg
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
+	"github.com/driquet/gopubg/models/clan"
+	"github.com/driquet/gopubg/models/mastery"
+	"github.com/driquet/gopubg/models/match"
 	"github.com/driquet/gopubg/models/player"
+	"github.com/driquet/gopubg/models/pubg"
+	"github.com/driquet/gopubg/models/sample"
+	"github.com/driquet/gopubg/models/season"
+	"github.com/driquet/gopubg/models/status"
+	"github.com/driquet/gopubg/models/telemetry"
+	"github.com/driquet/gopubg/models/tournament"
 )
 
+// MaxPlayersPerRequest is the maximum number of players that can be requested
+// at once
+const MaxPlayersPerRequest = 10
+
+// Errors returned by the API
+var (
+	// ErrPlayerNotFound is returned when a requested player does not exist on a shard
+	ErrPlayerNotFound = errors.New("player not found")
+	// ErrNoTelemetry is returned when a match does not reference any telemetry file
+	ErrNoTelemetry = errors.New("match has no telemetry asset")
+)
+
+// API is a client of the PUBG API
 type API struct {
 	Key string
+
+	client      *http.Client
+	transport   http.RoundTripper
+	baseURL     string
+	userAgent   string
+	limiter     *rateLimiter
+	retryPolicy RetryPolicy
+}
+
+// NewAPI creates a client of the PUBG API authenticated with the given key
+func NewAPI(key string, options ...Option) *API {
+	a := &API{
+		Key:         key,
+		client:      &http.Client{},
+		baseURL:     DefaultBaseURL,
+		limiter:     newRateLimiter(DefaultRateLimit),
+		retryPolicy: DefaultRetryPolicy,
+	}
+
+	for _, option := range options {
+		option(a)
+	}
+
+	if a.transport != nil {
+		client := *a.client
+		client.Transport = a.transport
+		a.client = &client
+	}
+
+	return a
+}
+
+// RequestStatus retrieves the status of the PUBG API
+func (a *API) RequestStatus() (*status.Status, error) {
+	return a.RequestStatusWithContext(context.Background())
+}
+
+// RequestStatusWithContext is like RequestStatus but with a context
+func (a *API) RequestStatusWithContext(ctx context.Context) (*status.Status, error) {
+	endpoint_url := a.endpointURL("/status", nil)
+
+	// The status does not require a key, and does not count against the rate
+	// limit
+	buffer, err := a.httpRequest(ctx, endpoint_url, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return status.ParseStatus(buffer)
+}
+
+// RequestSinglePlayerByName retrieves a player, and the references to its
+// recent matches, by its name
+func (a *API) RequestSinglePlayerByName(shard pubg.Shard, playerName string) (*player.Player, error) {
+	return a.RequestSinglePlayerByNameWithContext(context.Background(), shard, playerName)
+}
+
+// RequestSinglePlayerByNameWithContext is like RequestSinglePlayerByName but
+// with a context
+func (a *API) RequestSinglePlayerByNameWithContext(ctx context.Context, shard pubg.Shard, playerName string) (*player.Player, error) {
+	players, err := a.requestPlayers(ctx, shard, "filter[playerNames]", []string{playerName})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(players) == 0 {
+		return nil, ErrPlayerNotFound
+	}
+	return players[0], nil
+}
+
+// RequestPlayersByNames retrieves players by their names. Names are requested
+// by batches of MaxPlayersPerRequest, names that could not be found are
+// returned as the second value.
+func (a *API) RequestPlayersByNames(shard pubg.Shard, playerNames []string) ([]*player.Player, []string, error) {
+	return a.RequestPlayersByNamesWithContext(context.Background(), shard, playerNames)
+}
+
+// RequestPlayersByNamesWithContext is like RequestPlayersByNames but with a
+// context
+func (a *API) RequestPlayersByNamesWithContext(ctx context.Context, shard pubg.Shard, playerNames []string) ([]*player.Player, []string, error) {
+	players, err := a.requestPlayers(ctx, shard, "filter[playerNames]", playerNames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := make(map[string]bool, len(players))
+	for _, p := range players {
+		found[p.Name] = true
+	}
+	return players, missingKeys(playerNames, found), nil
+}
+
+// RequestPlayersByIDs retrieves players by their account IDs. IDs are
+// requested by batches of MaxPlayersPerRequest, IDs that could not be found
+// are returned as the second value.
+func (a *API) RequestPlayersByIDs(shard pubg.Shard, playerIDs []string) ([]*player.Player, []string, error) {
+	return a.RequestPlayersByIDsWithContext(context.Background(), shard, playerIDs)
+}
+
+// RequestPlayersByIDsWithContext is like RequestPlayersByIDs but with a
+// context
+func (a *API) RequestPlayersByIDsWithContext(ctx context.Context, shard pubg.Shard, playerIDs []string) ([]*player.Player, []string, error) {
+	players, err := a.requestPlayers(ctx, shard, "filter[playerIds]", playerIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := make(map[string]bool, len(players))
+	for _, p := range players {
+		found[p.ID] = true
+	}
+	return players, missingKeys(playerIDs, found), nil
+}
+
+// RequestMatch retrieves a match, including its rosters and participants
+func (a *API) RequestMatch(shard pubg.Shard, matchID string) (*match.Match, error) {
+	return a.RequestMatchWithContext(context.Background(), shard, matchID)
+}
+
+// RequestMatchWithContext is like RequestMatch but with a context
+func (a *API) RequestMatchWithContext(ctx context.Context, shard pubg.Shard, matchID string) (*match.Match, error) {
+	endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/matches/%s", url.PathEscape(matchID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return match.ParseSingleMatch(buffer)
+}
+
+// RequestTelemetry downloads and parses the telemetry file of a match. The
+// telemetry is hosted on a CDN and does not count against the rate limit.
+func (a *API) RequestTelemetry(m *match.Match) (*telemetry.Telemetry, error) {
+	return a.RequestTelemetryWithContext(context.Background(), m)
+}
+
+// RequestTelemetryWithContext is like RequestTelemetry but with a context
+func (a *API) RequestTelemetryWithContext(ctx context.Context, m *match.Match) (*telemetry.Telemetry, error) {
+	body, err := a.requestTelemetry(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return telemetry.ParseTelemetry(body)
+}
+
+// RequestTelemetryLenient is like RequestTelemetry but parses the telemetry
+// file with telemetry.ParseTelemetryLenient: unknown event types and values
+// do not fail the parsing and are listed in the returned report instead.
+func (a *API) RequestTelemetryLenient(m *match.Match) (*telemetry.Telemetry, *telemetry.ParseReport, error) {
+	return a.RequestTelemetryLenientWithContext(context.Background(), m)
+}
+
+// RequestTelemetryLenientWithContext is like RequestTelemetryLenient but with
+// a context
+func (a *API) RequestTelemetryLenientWithContext(ctx context.Context, m *match.Match) (*telemetry.Telemetry, *telemetry.ParseReport, error) {
+	body, err := a.requestTelemetry(ctx, m)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer body.Close()
+
+	return telemetry.ParseTelemetryLenient(body)
+}
+
+// RequestTelemetryDecoder downloads the telemetry file of a match, and returns
+// a decoder reading its events as they are downloaded. The returned closer
+// must be closed once the events are read.
+func (a *API) RequestTelemetryDecoder(m *match.Match) (*telemetry.Decoder, io.Closer, error) {
+	return a.RequestTelemetryDecoderWithContext(context.Background(), m)
+}
+
+// RequestTelemetryDecoderWithContext is like RequestTelemetryDecoder but with
+// a context
+func (a *API) RequestTelemetryDecoderWithContext(ctx context.Context, m *match.Match) (*telemetry.Decoder, io.Closer, error) {
+	body, err := a.requestTelemetry(ctx, m)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return telemetry.NewDecoder(body), body, nil
+}
+
+// RequestSeasons retrieves the list of seasons of a shard
+func (a *API) RequestSeasons(shard pubg.Shard) ([]*season.Season, error) {
+	return a.RequestSeasonsWithContext(context.Background(), shard)
+}
+
+// RequestSeasonsWithContext is like RequestSeasons but with a context
+func (a *API) RequestSeasonsWithContext(ctx context.Context, shard pubg.Shard) ([]*season.Season, error) {
+	endpoint_url, err := a.shardURL(shard, "/seasons", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return season.ParseSeasons(buffer)
+}
+
+// RequestPlayerSeasonStats retrieves the stats of a player, for each game
+// mode, during a season
+func (a *API) RequestPlayerSeasonStats(shard pubg.Shard, accountID, seasonID string) (*season.PlayerSeason, error) {
+	return a.RequestPlayerSeasonStatsWithContext(context.Background(), shard, accountID, seasonID)
+}
+
+// RequestPlayerSeasonStatsWithContext is like RequestPlayerSeasonStats but
+// with a context
+func (a *API) RequestPlayerSeasonStatsWithContext(ctx context.Context, shard pubg.Shard, accountID, seasonID string) (*season.PlayerSeason, error) {
+	endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/players/%s/seasons/%s", url.PathEscape(accountID), url.PathEscape(seasonID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return season.ParsePlayerSeason(buffer)
+}
+
+// RequestPlayerLifetimeStats retrieves the stats of a player, for each game
+// mode, over its whole career
+func (a *API) RequestPlayerLifetimeStats(shard pubg.Shard, accountID string) (*season.PlayerSeason, error) {
+	return a.RequestPlayerLifetimeStatsWithContext(context.Background(), shard, accountID)
+}
+
+// RequestPlayerLifetimeStatsWithContext is like RequestPlayerLifetimeStats
+// but with a context
+func (a *API) RequestPlayerLifetimeStatsWithContext(ctx context.Context, shard pubg.Shard, accountID string) (*season.PlayerSeason, error) {
+	return a.RequestPlayerSeasonStatsWithContext(ctx, shard, accountID, season.LifetimeID)
+}
+
+// RequestPlayersLifetimeStats retrieves the lifetime stats of several players
+// for a single game mode. Account IDs are requested by batches of
+// MaxPlayersPerRequest. Only pubg.StandardGameModes are supported.
+func (a *API) RequestPlayersLifetimeStats(shard pubg.Shard, gameMode pubg.GameMode, accountIDs []string) ([]*season.PlayerSeason, error) {
+	return a.RequestPlayersLifetimeStatsWithContext(context.Background(), shard, gameMode, accountIDs)
 }
 
-func NewAPI(key string) *API {
-	return &API{
-		Key: key,
+// RequestPlayersLifetimeStatsWithContext is like RequestPlayersLifetimeStats
+// but with a context
+func (a *API) RequestPlayersLifetimeStatsWithContext(ctx context.Context, shard pubg.Shard, gameMode pubg.GameMode, accountIDs []string) ([]*season.PlayerSeason, error) {
+	if !gameMode.IsStandard() {
+		return nil, fmt.Errorf("game mode %q not supported by lifetime stats", gameMode)
+	}
+
+	playerSeasons := make([]*season.PlayerSeason, 0, len(accountIDs))
+
+	for _, batch := range splitBatches(accountIDs, MaxPlayersPerRequest) {
+		parameters := url.Values{
+			"filter[playerIds]": {strings.Join(batch, ",")},
+		}
+
+		endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/seasons/%s/gameMode/%s/players", season.LifetimeID, url.PathEscape(string(gameMode))), parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer, err := a.httpRequest(ctx, endpoint_url, true)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := season.ParsePlayerSeasons(buffer)
+		if err != nil {
+			return nil, err
+		}
+		playerSeasons = append(playerSeasons, result...)
 	}
+
+	return playerSeasons, nil
+}
+
+// RequestClan retrieves a clan by its ID, as found in player.Player.ClanID
+func (a *API) RequestClan(shard pubg.Shard, clanID string) (*clan.Clan, error) {
+	return a.RequestClanWithContext(context.Background(), shard, clanID)
 }
 
-func (a *API) RequestStatus() error {
-	endpoint_url := "https://api.playbattlegrounds.com/status"
+// RequestClanWithContext is like RequestClan but with a context
+func (a *API) RequestClanWithContext(ctx context.Context, shard pubg.Shard, clanID string) (*clan.Clan, error) {
+	endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/clans/%s", url.PathEscape(clanID)), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	buffer, err := httpRequest(endpoint_url, a.Key)
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	fmt.Printf("data:\n%s\n", buffer)
+	return clan.ParseClan(buffer)
+}
 
-	return nil
+// RequestWeaponMastery retrieves the weapon mastery of a player
+func (a *API) RequestWeaponMastery(shard pubg.Shard, accountID string) (*mastery.WeaponMastery, error) {
+	return a.RequestWeaponMasteryWithContext(context.Background(), shard, accountID)
 }
 
-func (a *API) RequestSinglePlayerByName(shard, playerName string) (*player.Player, error) {
-	parameters := url.Values{
-		"filter[playerNames]": {playerName},
+// RequestWeaponMasteryWithContext is like RequestWeaponMastery but with a
+// context
+func (a *API) RequestWeaponMasteryWithContext(ctx context.Context, shard pubg.Shard, accountID string) (*mastery.WeaponMastery, error) {
+	endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/players/%s/weapon_mastery", url.PathEscape(accountID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
 	}
 
-	endpoint_url := fmt.Sprintf("https://api.playbattlegrounds.com/shards/%s/players?%s", shard, parameters.Encode())
+	return mastery.ParseWeaponMastery(buffer)
+}
+
+// RequestSurvivalMastery retrieves the survival mastery of a player
+func (a *API) RequestSurvivalMastery(shard pubg.Shard, accountID string) (*mastery.SurvivalMastery, error) {
+	return a.RequestSurvivalMasteryWithContext(context.Background(), shard, accountID)
+}
+
+// RequestSurvivalMasteryWithContext is like RequestSurvivalMastery but with a
+// context
+func (a *API) RequestSurvivalMasteryWithContext(ctx context.Context, shard pubg.Shard, accountID string) (*mastery.SurvivalMastery, error) {
+	endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/players/%s/survival_mastery", url.PathEscape(accountID)), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	buffer, err := httpRequest(endpoint_url, a.Key)
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Printf("data:\n%s\n", buffer)
+	return mastery.ParseSurvivalMastery(buffer)
+}
+
+// RequestSamples retrieves the IDs of random matches of a shard, played after
+// since. A zero since lets the API pick the most recent matches.
+func (a *API) RequestSamples(shard pubg.Shard, since time.Time) ([]string, error) {
+	return a.RequestSamplesWithContext(context.Background(), shard, since)
+}
 
-	// TODO parse player
-	return nil, nil
+// RequestSamplesWithContext is like RequestSamples but with a context
+func (a *API) RequestSamplesWithContext(ctx context.Context, shard pubg.Shard, since time.Time) ([]string, error) {
+	parameters := url.Values{}
+	if !since.IsZero() {
+		parameters.Set("filter[createdAt-start]", since.UTC().Format(time.RFC3339))
+	}
+
+	endpoint_url, err := a.shardURL(shard, "/samples", parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := sample.ParseSample(buffer)
+	if err != nil {
+		return nil, err
+	}
+	return s.MatchIDs(), nil
+}
+
+// RequestTournaments retrieves the list of tournaments
+func (a *API) RequestTournaments() ([]*tournament.Tournament, error) {
+	return a.RequestTournamentsWithContext(context.Background())
+}
+
+// RequestTournamentsWithContext is like RequestTournaments but with a context
+func (a *API) RequestTournamentsWithContext(ctx context.Context) ([]*tournament.Tournament, error) {
+	endpoint_url := a.endpointURL("/tournaments", nil)
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return tournament.ParseTournaments(buffer)
+}
+
+// RequestTournament retrieves a tournament and its matches. The matches can
+// be requested with RequestMatch on the pubg.ShardTournament shard.
+func (a *API) RequestTournament(tournamentID string) (*tournament.Tournament, error) {
+	return a.RequestTournamentWithContext(context.Background(), tournamentID)
+}
+
+// RequestTournamentWithContext is like RequestTournament but with a context
+func (a *API) RequestTournamentWithContext(ctx context.Context, tournamentID string) (*tournament.Tournament, error) {
+	endpoint_url := a.endpointURL(fmt.Sprintf("/tournaments/%s", url.PathEscape(tournamentID)), nil)
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return tournament.ParseTournament(buffer)
+}
+
+// requestTelemetry starts the download of the telemetry file of a match
+func (a *API) requestTelemetry(ctx context.Context, m *match.Match) (io.ReadCloser, error) {
+	telemetryURL := m.TelemetryURL()
+	if telemetryURL == "" {
+		return nil, ErrNoTelemetry
+	}
+
+	// Telemetry files are not authenticated
+	return a.httpStream(ctx, telemetryURL, false)
+}
+
+// requestPlayers runs as many players requests as needed to look for all the
+// values of the given filter
+func (a *API) requestPlayers(ctx context.Context, shard pubg.Shard, filter string, values []string) ([]*player.Player, error) {
+	players := make([]*player.Player, 0, len(values))
+
+	for _, batch := range splitBatches(values, MaxPlayersPerRequest) {
+		parameters := url.Values{
+			filter: {strings.Join(batch, ",")},
+		}
+
+		endpoint_url, err := a.shardURL(shard, "/players", parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer, err := a.httpRequest(ctx, endpoint_url, true)
+		if IsNotFound(err) {
+			// The API replies 404 when none of the players of the batch exist,
+			// they are reported as missing
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := player.ParsePlayers(buffer)
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, result...)
+	}
+
+	return players, nil
+}
+
+// splitBatches splits values into batches of at most size elements
+func splitBatches(values []string, size int) [][]string {
+	batches := make([][]string, 0, (len(values)+size-1)/size)
+	for len(values) > size {
+		batches = append(batches, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		batches = append(batches, values)
+	}
+	return batches
+}
+
+// missingKeys returns the keys that are not marked as found
+func missingKeys(keys []string, found map[string]bool) []string {
+	missing := make([]string, 0)
+	for _, key := range keys {
+		if !found[key] {
+			missing = append(missing, key)
+		}
+	}
+	return missing
 }
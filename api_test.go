@@ -0,0 +1,100 @@
+package gopubg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/driquet/gopubg/models/pubg"
+)
+
+// newTestAPI returns an API sending its requests to a test server, without
+// rate limiter nor retries. The server must be closed by the caller.
+func newTestAPI(handler http.HandlerFunc) (*API, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	return NewAPI("key", WithBaseURL(server.URL), WithRateLimit(0), WithRetryPolicy(NoRetry)), server
+}
+
+func TestRequestSinglePlayerByNameNotFound(t *testing.T) {
+	api, server := newTestAPI(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errors":[{"title":"Not Found"}]}`, http.StatusNotFound)
+	})
+	defer server.Close()
+
+	p, err := api.RequestSinglePlayerByName(pubg.ShardSteam, "unknown")
+	if err != ErrPlayerNotFound {
+		t.Fatalf("expected ErrPlayerNotFound, got %v", err)
+	}
+	if p != nil {
+		t.Fatalf("expected no player, got %v", p)
+	}
+}
+
+func TestRequestPlayersByNamesAllMissing(t *testing.T) {
+	requests := 0
+	api, server := newTestAPI(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, `{"errors":[{"title":"Not Found"}]}`, http.StatusNotFound)
+	})
+	defer server.Close()
+
+	names := make([]string, MaxPlayersPerRequest+2)
+	for idx := range names {
+		names[idx] = fmt.Sprintf("player%d", idx)
+	}
+
+	players, missing, err := api.RequestPlayersByNames(pubg.ShardSteam, names)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(players) != 0 {
+		t.Errorf("expected no player, got %d", len(players))
+	}
+	if !reflect.DeepEqual(missing, names) {
+		t.Errorf("expected all names to be missing, got %v", missing)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestSplitBatches(t *testing.T) {
+	tests := []struct {
+		values   []string
+		size     int
+		expected [][]string
+	}{
+		{nil, 2, [][]string{}},
+		{[]string{"a"}, 2, [][]string{{"a"}}},
+		{[]string{"a", "b"}, 2, [][]string{{"a", "b"}}},
+		{[]string{"a", "b", "c"}, 2, [][]string{{"a", "b"}, {"c"}}},
+	}
+
+	for _, test := range tests {
+		batches := splitBatches(test.values, test.size)
+		if !reflect.DeepEqual(batches, test.expected) {
+			t.Errorf("splitBatches(%v, %d) = %v, expected %v", test.values, test.size, batches, test.expected)
+		}
+	}
+}
+
+func TestUnknownShardOrGameMode(t *testing.T) {
+	api, server := newTestAPI(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	})
+	defer server.Close()
+
+	if _, err := api.RequestSeasons(pubg.Shard("moon")); err == nil {
+		t.Error("expected an error on an unknown shard")
+	}
+	if _, err := api.RequestLeaderboard(pubg.ShardSteam, "season", pubg.GameMode("chess"), 0); err == nil {
+		t.Error("expected an error on an unknown game mode")
+	}
+	for _, gameMode := range []pubg.GameMode{"chess", pubg.GameModeNormalSquadFPP, pubg.GameModeTDM} {
+		if _, err := api.RequestPlayersLifetimeStats(pubg.ShardSteam, gameMode, []string{"account"}); err == nil {
+			t.Errorf("expected an error on lifetime stats of game mode %q", gameMode)
+		}
+	}
+}
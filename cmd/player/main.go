@@ -6,13 +6,14 @@ import (
 	"os"
 
 	"github.com/driquet/gopubg"
+	"github.com/driquet/gopubg/models/pubg"
 	"github.com/sirupsen/logrus"
 )
 
 var (
 	key        string
 	playerName string
-	shard      string
+	shardName  string
 )
 
 func usage() {
@@ -25,21 +26,32 @@ func init() {
 	// Parameters
 	flag.StringVar(&key, "key", "", "api key")
 	flag.StringVar(&playerName, "name", "", "player name")
-	flag.StringVar(&shard, "shard", "", "shard")
+	flag.StringVar(&shardName, "shard", "", "shard")
 
 	// Parse parameters
 	flag.Parse()
 
 	// Verify parameters
-	if key == "" || playerName == "" || shard == "" {
+	if key == "" || playerName == "" || shardName == "" {
 		usage()
 	}
 }
 
 func main() {
+	shard, err := pubg.ParseShard(shardName)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+
 	api := gopubg.NewAPI(key)
-	_, err := api.RequestSinglePlayerByName(shard, playerName)
+	p, err := api.RequestSinglePlayerByName(shard, playerName)
 	if err != nil {
 		logrus.Fatal(err)
 	}
+
+	fmt.Printf("%s (%s) on %s\n", p.Name, p.ID, p.ShardID)
+	fmt.Printf("%d matches\n", len(p.Matches))
+	for _, m := range p.Matches {
+		fmt.Printf(" - %s\n", m.ID)
+	}
 }
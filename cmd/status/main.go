@@ -1,16 +1,19 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/driquet/gopubg"
 	"github.com/sirupsen/logrus"
 )
 
 var (
-	key string
+	key        string
+	jsonOutput bool
 )
 
 func usage() {
@@ -22,6 +25,7 @@ func usage() {
 func init() {
 	// Parameters
 	flag.StringVar(&key, "key", "", "api key")
+	flag.BoolVar(&jsonOutput, "json", false, "print status as json")
 
 	// Parse parameters
 	flag.Parse()
@@ -34,8 +38,21 @@ func init() {
 
 func main() {
 	api := gopubg.NewAPI(key)
-	err := api.RequestStatus()
+	s, err := api.RequestStatus()
 	if err != nil {
 		logrus.Fatal(err)
 	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(s); err != nil {
+			logrus.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Printf("id:       %s\n", s.ID)
+	fmt.Printf("version:  %s\n", s.Version)
+	fmt.Printf("released: %s\n", s.ReleasedAt.Format(time.RFC3339))
 }
@@ -10,7 +10,8 @@ import (
 )
 
 var (
-	input string
+	input   string
+	lenient bool
 )
 
 func usage() {
@@ -22,6 +23,7 @@ func usage() {
 func init() {
 	// Parameters
 	flag.StringVar(&input, "input", "", "input file")
+	flag.BoolVar(&lenient, "lenient", false, "accept unknown event types and values")
 
 	// Parse parameters
 	flag.Parse()
@@ -40,13 +42,28 @@ func main() {
 		logrus.Fatal(err)
 	}
 
-	t, err := telemetry.ParseTelemetry(file)
-	if err != nil {
-		logrus.Fatal(err)
+	var t *telemetry.Telemetry
+	if lenient {
+		var report *telemetry.ParseReport
+		t, report, err = telemetry.ParseTelemetryLenient(file)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+		for value, count := range report.Unknown {
+			fmt.Printf("unknown %s %q (%d occurrences)\n", value.Kind, value.Value, count)
+		}
+	} else {
+		t, err = telemetry.ParseTelemetry(file)
+		if err != nil {
+			logrus.Fatal(err)
+		}
 	}
 
 	fmt.Printf("%d events parsed\n", len(t.Events))
 	fmt.Printf("%d players\n", len(t.Players))
+	if m := t.Map(); m != nil {
+		fmt.Printf("map: %s\n", m.DisplayName)
+	}
 
 	for _, player := range t.Players {
 		fmt.Printf(" - %s (%d events, ranking=%d)\n", player.Name, len(player.Events), player.Ranking)
@@ -54,7 +71,7 @@ func main() {
 			fmt.Printf("winner: %s\n", player.Name)
 
 			for idx, evt := range player.Events {
-				fmt.Printf("%d: %s\n", idx, telemetry.KnownEventTypes[evt.Type])
+				fmt.Printf("%d: %s\n", idx, evt.RawType)
 			}
 			break
 		}
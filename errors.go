@@ -0,0 +1,85 @@
+package gopubg
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when the API replies with an unexpected status code
+type APIError struct {
+	StatusCode int
+	Status     string
+	RateLimit  RateLimit
+	Errors     []ErrorObject
+}
+
+// ErrorObject represents an entry of the errors array of a JSON:API error
+// document
+type ErrorObject struct {
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// newAPIError builds an APIError from a response and its body, if any
+func newAPIError(response *http.Response, body *bytes.Buffer) *APIError {
+	rateLimit, _ := parseRateLimit(response.Header)
+	apiError := &APIError{
+		StatusCode: response.StatusCode,
+		Status:     response.Status,
+		RateLimit:  rateLimit,
+	}
+
+	if body != nil {
+		var document struct {
+			Errors []ErrorObject `json:"errors"`
+		}
+		if err := json.Unmarshal(body.Bytes(), &document); err == nil {
+			apiError.Errors = document.Errors
+		}
+	}
+
+	return apiError
+}
+
+// Error describes the failed request with its HTTP status followed by the
+// titles and details of the decoded JSON:API errors, if any
+func (e *APIError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("HTTP request failed: %s", e.Status)
+	}
+
+	messages := make([]string, len(e.Errors))
+	for idx, errorObject := range e.Errors {
+		messages[idx] = errorObject.Title
+		if errorObject.Detail != "" {
+			messages[idx] += ": " + errorObject.Detail
+		}
+	}
+	return fmt.Sprintf("HTTP request failed: %s (%s)", e.Status, strings.Join(messages, ", "))
+}
+
+// hasStatusCode checks whether err is, or wraps, an APIError with the given
+// status code
+func hasStatusCode(err error, statusCode int) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == statusCode
+}
+
+// IsNotFound checks whether err reports a missing resource
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsRateLimited checks whether err reports an exceeded rate limit
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized checks whether err reports a missing or invalid API key
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
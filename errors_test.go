@@ -0,0 +1,33 @@
+package gopubg
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIsStatusCode(t *testing.T) {
+	notFound := &APIError{StatusCode: http.StatusNotFound}
+
+	tests := []struct {
+		name     string
+		err      error
+		check    func(error) bool
+		expected bool
+	}{
+		{"nil", nil, IsNotFound, false},
+		{"other error", errors.New("boom"), IsNotFound, false},
+		{"not found", notFound, IsNotFound, true},
+		{"wrapped not found", fmt.Errorf("request player: %w", notFound), IsNotFound, true},
+		{"other status", notFound, IsUnauthorized, false},
+		{"rate limited", &APIError{StatusCode: http.StatusTooManyRequests}, IsRateLimited, true},
+		{"unauthorized", &APIError{StatusCode: http.StatusUnauthorized}, IsUnauthorized, true},
+	}
+
+	for _, test := range tests {
+		if result := test.check(test.err); result != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, result)
+		}
+	}
+}
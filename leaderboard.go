@@ -0,0 +1,123 @@
+package gopubg
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/driquet/gopubg/models/leaderboard"
+	"github.com/driquet/gopubg/models/pubg"
+)
+
+// RequestLeaderboard retrieves a page of the leaderboard of a game mode during
+// a season. Pages are numbered from zero.
+func (a *API) RequestLeaderboard(shard pubg.Shard, seasonID string, gameMode pubg.GameMode, page int) (*leaderboard.Leaderboard, error) {
+	return a.RequestLeaderboardWithContext(context.Background(), shard, seasonID, gameMode, page)
+}
+
+// RequestLeaderboardWithContext is like RequestLeaderboard but with a context
+func (a *API) RequestLeaderboardWithContext(ctx context.Context, shard pubg.Shard, seasonID string, gameMode pubg.GameMode, page int) (*leaderboard.Leaderboard, error) {
+	if _, err := pubg.ParseGameMode(string(gameMode)); err != nil {
+		return nil, err
+	}
+
+	parameters := url.Values{
+		"page[number]": {strconv.Itoa(page)},
+	}
+
+	endpoint_url, err := a.shardURL(shard, fmt.Sprintf("/leaderboards/%s/%s", url.PathEscape(seasonID), url.PathEscape(string(gameMode))), parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err := a.httpRequest(ctx, endpoint_url, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return leaderboard.ParseLeaderboard(buffer)
+}
+
+// LeaderboardIterator walks the entries of a leaderboard, requesting its
+// pages as needed:
+//
+//	it := api.IterateLeaderboard(shard, seasonID, gameMode)
+//	for it.Next() {
+//		player := it.Player()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type LeaderboardIterator struct {
+	api      *API
+	ctx      context.Context
+	shard    pubg.Shard
+	seasonID string
+	gameMode pubg.GameMode
+
+	page    int
+	players []*leaderboard.Player
+	current *leaderboard.Player
+	done    bool
+	err     error
+}
+
+// IterateLeaderboard returns an iterator over all the entries of the
+// leaderboard of a game mode during a season
+func (a *API) IterateLeaderboard(shard pubg.Shard, seasonID string, gameMode pubg.GameMode) *LeaderboardIterator {
+	return a.IterateLeaderboardWithContext(context.Background(), shard, seasonID, gameMode)
+}
+
+// IterateLeaderboardWithContext is like IterateLeaderboard but with a context
+func (a *API) IterateLeaderboardWithContext(ctx context.Context, shard pubg.Shard, seasonID string, gameMode pubg.GameMode) *LeaderboardIterator {
+	return &LeaderboardIterator{
+		api:      a,
+		ctx:      ctx,
+		shard:    shard,
+		seasonID: seasonID,
+		gameMode: gameMode,
+	}
+}
+
+// Next advances to the next entry of the leaderboard. It returns false once
+// all the pages were walked, or when a request failed.
+func (it *LeaderboardIterator) Next() bool {
+	for len(it.players) == 0 {
+		if it.done {
+			it.current = nil
+			return false
+		}
+		it.fetch()
+	}
+
+	it.current, it.players = it.players[0], it.players[1:]
+	return true
+}
+
+// fetch requests the next page of the leaderboard. An empty page, or a
+// missing page after the first one, marks the end of the leaderboard.
+func (it *LeaderboardIterator) fetch() {
+	page, err := it.api.RequestLeaderboardWithContext(it.ctx, it.shard, it.seasonID, it.gameMode, it.page)
+	switch {
+	case IsNotFound(err) && it.page > 0:
+		it.done = true
+	case err != nil:
+		it.err = err
+		it.done = true
+	case len(page.Players) == 0:
+		it.done = true
+	default:
+		it.players = page.Players
+		it.page++
+	}
+}
+
+// Player returns the current entry of the leaderboard
+func (it *LeaderboardIterator) Player() *leaderboard.Player {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *LeaderboardIterator) Err() error {
+	return it.err
+}
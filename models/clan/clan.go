@@ -0,0 +1,25 @@
+package clan
+
+import (
+	"io"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// Clan structure represents a clan of players
+type Clan struct {
+	ID          string `jsonapi:"primary,clan"`
+	Name        string `jsonapi:"attr,clanName"`
+	Tag         string `jsonapi:"attr,clanTag"`
+	Level       int    `jsonapi:"attr,clanLevel"`
+	MemberCount int    `jsonapi:"attr,clanMemberCount"`
+}
+
+// ParseClan parses a json response containing clan information
+func ParseClan(in io.Reader) (*Clan, error) {
+	clan := new(Clan)
+	if err := jsonapi.UnmarshalPayload(in, clan); err != nil {
+		return nil, err
+	}
+	return clan, nil
+}
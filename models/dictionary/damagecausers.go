@@ -0,0 +1,157 @@
+package dictionary
+
+// damageCausers maps damage causer names, as found in telemetry events, to their
+// names
+var damageCausers = map[string]Entry{
+	"AIPawn_Base_Female_C":                    {Name: "AI Player", Category: CategoryPlayer},
+	"AIPawn_Base_Male_C":                      {Name: "AI Player", Category: CategoryPlayer},
+	"AquaRail_A_01_C":                         {Name: "Aquarail", Category: CategoryVehicle},
+	"AquaRail_A_02_C":                         {Name: "Aquarail", Category: CategoryVehicle},
+	"AquaRail_A_03_C":                         {Name: "Aquarail", Category: CategoryVehicle},
+	"BattleRoyaleModeController_Chimera_C":    {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Def_C":        {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Desert_C":     {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_DihorOtok_C":  {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Heaven_C":     {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Kiki_C":       {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Neon_C":       {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Savage_C":     {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Summerland_C": {Name: "Bluezone", Category: CategoryEnvironment},
+	"BattleRoyaleModeController_Tiger_C":      {Name: "Bluezone", Category: CategoryEnvironment},
+	"BlackZoneController_Def_C":               {Name: "Blackzone", Category: CategoryEnvironment},
+	"BluezoneBomb_EffectActor_C":              {Name: "Bluezone Grenade", Category: CategoryThrowable},
+	"Boat_PG117_C":                            {Name: "PG-117", Category: CategoryVehicle},
+	"BP_ATV_C":                                {Name: "Quad", Category: CategoryVehicle},
+	"BP_Bicycle_C":                            {Name: "Mountain Bike", Category: CategoryVehicle},
+	"BP_Blanc_C":                              {Name: "Blanc", Category: CategoryVehicle},
+	"BP_BRDM_C":                               {Name: "BRDM-2", Category: CategoryVehicle},
+	"BP_CoupeRB_C":                            {Name: "Coupe RB", Category: CategoryVehicle},
+	"BP_Dirtbike_C":                           {Name: "Dirt Bike", Category: CategoryVehicle},
+	"BP_DO_Circle_Train_Merged_C":             {Name: "Train", Category: CategoryEnvironment},
+	"BP_DO_Line_Train_Dino_Merged_C":          {Name: "Train", Category: CategoryEnvironment},
+	"BP_DO_Line_Train_Merged_C":               {Name: "Train", Category: CategoryEnvironment},
+	"BP_EmergencyPickupVehicle_C":             {Name: "Emergency Pickup", Category: CategoryVehicle},
+	"BP_KillTruck_C":                          {Name: "Kill Truck", Category: CategoryVehicle},
+	"BP_LootTruck_C":                          {Name: "Loot Truck", Category: CategoryVehicle},
+	"BP_M_Rony_A_01_C":                        {Name: "Rony", Category: CategoryVehicle},
+	"BP_M_Rony_A_02_C":                        {Name: "Rony", Category: CategoryVehicle},
+	"BP_M_Rony_A_03_C":                        {Name: "Rony", Category: CategoryVehicle},
+	"BP_Mirado_A_01_C":                        {Name: "Mirado", Category: CategoryVehicle},
+	"BP_Mirado_A_02_C":                        {Name: "Mirado", Category: CategoryVehicle},
+	"BP_Mirado_Open_03_C":                     {Name: "Mirado (open top)", Category: CategoryVehicle},
+	"BP_Motorbike_04_C":                       {Name: "Motorcycle", Category: CategoryVehicle},
+	"BP_Motorbike_04_Desert_C":                {Name: "Motorcycle", Category: CategoryVehicle},
+	"BP_Motorbike_04_SideCar_C":               {Name: "Motorcycle (w/ Sidecar)", Category: CategoryVehicle},
+	"BP_Motorbike_04_SideCar_Desert_C":        {Name: "Motorcycle (w/ Sidecar)", Category: CategoryVehicle},
+	"BP_Motorglider_C":                        {Name: "Motor Glider", Category: CategoryVehicle},
+	"BP_Niva_01_C":                            {Name: "Zima", Category: CategoryVehicle},
+	"BP_Niva_02_C":                            {Name: "Zima", Category: CategoryVehicle},
+	"BP_PickupTruck_A_01_C":                   {Name: "Pickup Truck (closed top)", Category: CategoryVehicle},
+	"BP_PickupTruck_B_01_C":                   {Name: "Pickup Truck (open top)", Category: CategoryVehicle},
+	"BP_PonyCoupe_C":                          {Name: "Pony Coupe", Category: CategoryVehicle},
+	"BP_Porter_C":                             {Name: "Porter", Category: CategoryVehicle},
+	"BP_Scooter_01_A_C":                       {Name: "Scooter", Category: CategoryVehicle},
+	"BP_Scooter_02_A_C":                       {Name: "Scooter", Category: CategoryVehicle},
+	"BP_Snowbike_01_C":                        {Name: "Snowbike", Category: CategoryVehicle},
+	"BP_Snowmobile_01_C":                      {Name: "Snowmobile", Category: CategoryVehicle},
+	"BP_Spiketrap_C":                          {Name: "Spike Trap", Category: CategoryThrowable},
+	"BP_Spiketrap_Projectile_C":               {Name: "Spike Trap", Category: CategoryThrowable},
+	"BP_TukTukTuk_A_01_C":                     {Name: "Tukshai", Category: CategoryVehicle},
+	"BP_Van_A_01_C":                           {Name: "Van", Category: CategoryVehicle},
+	"BP_Van_A_02_C":                           {Name: "Van", Category: CategoryVehicle},
+	"Buff_DecreaseBreathInApnea_C":            {Name: "Drowning", Category: CategoryEnvironment},
+	"Buggy_A_01_C":                            {Name: "Buggy", Category: CategoryVehicle},
+	"Buggy_A_02_C":                            {Name: "Buggy", Category: CategoryVehicle},
+	"Buggy_A_03_C":                            {Name: "Buggy", Category: CategoryVehicle},
+	"Carapackage_RedBox_C":                    {Name: "Care Package", Category: CategoryEnvironment},
+	"Carepackage_Container_C":                 {Name: "Care Package", Category: CategoryEnvironment},
+	"Crashed_Carepackage_C":                   {Name: "Care Package", Category: CategoryEnvironment},
+	"Dacia_A_01_v2_C":                         {Name: "Dacia", Category: CategoryVehicle},
+	"Dacia_A_02_v2_C":                         {Name: "Dacia", Category: CategoryVehicle},
+	"Dacia_A_03_v2_C":                         {Name: "Dacia", Category: CategoryVehicle},
+	"Dacia_A_04_v2_C":                         {Name: "Dacia", Category: CategoryVehicle},
+	"DummyTransportAircraft_C":                {Name: "C-130", Category: CategoryVehicle},
+	"EmergencyAircraft_Tiger_C":               {Name: "Emergency Aircraft", Category: CategoryVehicle},
+	"Jerrycan":                                {Name: "Jerrycan", Category: CategoryEnvironment},
+	"JerrycanFire":                            {Name: "Jerrycan Fire", Category: CategoryEnvironment},
+	"Mortar_Projectile_C":                     {Name: "Mortar Projectile", Category: CategoryWeapon},
+	"None":                                    {Name: "None", Category: CategoryEnvironment},
+	"PanzerFaust100M_Projectile_C":            {Name: "Panzerfaust Projectile", Category: CategoryWeapon},
+	"ParachutePlayer_C":                       {Name: "Parachute", Category: CategoryVehicle},
+	"PlayerFemale_A_C":                        {Name: "Player", Category: CategoryPlayer},
+	"PlayerMale_A_C":                          {Name: "Player", Category: CategoryPlayer},
+	"ProjC4_C":                                {Name: "C4", Category: CategoryThrowable},
+	"ProjGrenade_C":                           {Name: "Frag Grenade", Category: CategoryThrowable},
+	"ProjMolotov_C":                           {Name: "Molotov Cocktail", Category: CategoryThrowable},
+	"ProjMolotov_DamageField_Direct_C":        {Name: "Molotov Cocktail Fire Field", Category: CategoryThrowable},
+	"ProjPanzerFaust100M_C":                   {Name: "Panzerfaust", Category: CategoryWeapon},
+	"ProjStickyGrenade_C":                     {Name: "Sticky Bomb", Category: CategoryThrowable},
+	"RedZoneBomb_C":                           {Name: "Redzone", Category: CategoryEnvironment},
+	"RedZoneBombingField_C":                   {Name: "Redzone", Category: CategoryEnvironment},
+	"RedZoneBombingField_Def_C":               {Name: "Redzone", Category: CategoryEnvironment},
+	"TransportAircraft_Chimera_C":             {Name: "Helicopter", Category: CategoryVehicle},
+	"TransportAircraft_Tiger_C":               {Name: "C-130", Category: CategoryVehicle},
+	"Uaz_A_01_C":                              {Name: "UAZ (open top)", Category: CategoryVehicle},
+	"Uaz_B_01_C":                              {Name: "UAZ (soft top)", Category: CategoryVehicle},
+	"Uaz_C_01_C":                              {Name: "UAZ (hard top)", Category: CategoryVehicle},
+	"WeapACE32_C":                             {Name: "ACE32", Category: CategoryWeapon},
+	"WeapAK47_C":                              {Name: "AKM", Category: CategoryWeapon},
+	"WeapAUG_C":                               {Name: "AUG A3", Category: CategoryWeapon},
+	"WeapAWM_C":                               {Name: "AWM", Category: CategoryWeapon},
+	"WeapBerreta686_C":                        {Name: "S686", Category: CategoryWeapon},
+	"WeapBerylM762_C":                         {Name: "Beryl", Category: CategoryWeapon},
+	"WeapBizonPP19_C":                         {Name: "Bizon", Category: CategoryWeapon},
+	"WeapCowbar_C":                            {Name: "Crowbar", Category: CategoryMelee},
+	"WeapCrossbow_1_C":                        {Name: "Crossbow", Category: CategoryWeapon},
+	"WeapDesertEagle_C":                       {Name: "Deagle", Category: CategoryWeapon},
+	"WeapDP12_C":                              {Name: "DBS", Category: CategoryWeapon},
+	"WeapDP28_C":                              {Name: "DP-28", Category: CategoryWeapon},
+	"WeapDragunov_C":                          {Name: "Dragunov", Category: CategoryWeapon},
+	"WeapFamasG2_C":                           {Name: "FAMAS", Category: CategoryWeapon},
+	"WeapFlareGun_C":                          {Name: "Flare Gun", Category: CategoryWeapon},
+	"WeapFNFal_C":                             {Name: "SLR", Category: CategoryWeapon},
+	"WeapG18_C":                               {Name: "P18C", Category: CategoryWeapon},
+	"WeapG36C_C":                              {Name: "G36C", Category: CategoryWeapon},
+	"WeapGroza_C":                             {Name: "Groza", Category: CategoryWeapon},
+	"WeapHK416_C":                             {Name: "M416", Category: CategoryWeapon},
+	"WeapJS9_C":                               {Name: "JS9", Category: CategoryWeapon},
+	"WeapK2_C":                                {Name: "K2", Category: CategoryWeapon},
+	"WeapKar98k_C":                            {Name: "Kar98k", Category: CategoryWeapon},
+	"WeapL6_C":                                {Name: "Lynx AMR", Category: CategoryWeapon},
+	"WeapM16A4_C":                             {Name: "M16A4", Category: CategoryWeapon},
+	"WeapM1911_C":                             {Name: "P1911", Category: CategoryWeapon},
+	"WeapM249_C":                              {Name: "M249", Category: CategoryWeapon},
+	"WeapM24_C":                               {Name: "M24", Category: CategoryWeapon},
+	"WeapM9_C":                                {Name: "P92", Category: CategoryWeapon},
+	"WeapMachete_C":                           {Name: "Machete", Category: CategoryMelee},
+	"WeapMG3_C":                               {Name: "MG3", Category: CategoryWeapon},
+	"WeapMini14_C":                            {Name: "Mini 14", Category: CategoryWeapon},
+	"WeapMk12_C":                              {Name: "Mk12", Category: CategoryWeapon},
+	"WeapMk14_C":                              {Name: "Mk14 EBR", Category: CategoryWeapon},
+	"WeapMk47Mutant_C":                        {Name: "Mk47 Mutant", Category: CategoryWeapon},
+	"WeapMortar_C":                            {Name: "Mortar", Category: CategoryWeapon},
+	"WeapMosinNagant_C":                       {Name: "Mosin-Nagant", Category: CategoryWeapon},
+	"WeapMP5K_C":                              {Name: "MP5K", Category: CategoryWeapon},
+	"WeapMP9_C":                               {Name: "MP9", Category: CategoryWeapon},
+	"WeapNagantM1895_C":                       {Name: "R1895", Category: CategoryWeapon},
+	"WeapOriginS12_C":                         {Name: "O12", Category: CategoryWeapon},
+	"WeapP90_C":                               {Name: "P90", Category: CategoryWeapon},
+	"WeapPan_C":                               {Name: "Pan", Category: CategoryMelee},
+	"WeapPanzerFaust100M1_C":                  {Name: "Panzerfaust", Category: CategoryWeapon},
+	"WeapQBU88_C":                             {Name: "QBU", Category: CategoryWeapon},
+	"WeapQBZ95_C":                             {Name: "QBZ", Category: CategoryWeapon},
+	"WeapRhino_C":                             {Name: "R45", Category: CategoryWeapon},
+	"WeapSaiga12_C":                           {Name: "S12K", Category: CategoryWeapon},
+	"WeapSawnoff_C":                           {Name: "Sawed-off", Category: CategoryWeapon},
+	"WeapSCAR-L_C":                            {Name: "SCAR-L", Category: CategoryWeapon},
+	"WeapSickle_C":                            {Name: "Sickle", Category: CategoryMelee},
+	"WeapSKS_C":                               {Name: "SKS", Category: CategoryWeapon},
+	"WeapThompson_C":                          {Name: "Tommy Gun", Category: CategoryWeapon},
+	"WeapUMP_C":                               {Name: "UMP", Category: CategoryWeapon},
+	"WeapUZI_C":                               {Name: "Micro UZI", Category: CategoryWeapon},
+	"WeapVector_C":                            {Name: "Vector", Category: CategoryWeapon},
+	"WeapVSS_C":                               {Name: "VSS", Category: CategoryWeapon},
+	"Weapvz61Skorpion_C":                      {Name: "Skorpion", Category: CategoryWeapon},
+	"WeapWin94_C":                             {Name: "Win94", Category: CategoryWeapon},
+	"WeapWinchester_C":                        {Name: "S1897", Category: CategoryWeapon},
+}
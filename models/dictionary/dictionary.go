@@ -0,0 +1,68 @@
+package dictionary
+
+// Entry structure represents the human readable form of an internal
+// identifier
+type Entry struct {
+	Name     string
+	Category string
+}
+
+// Entry categories
+const (
+	CategoryWeapon      = "Weapon"
+	CategoryMelee       = "Melee"
+	CategoryThrowable   = "Throwable"
+	CategoryAmmunition  = "Ammunition"
+	CategoryAttachment  = "Attachment"
+	CategoryEquipment   = "Equipment"
+	CategoryHeal        = "Heal"
+	CategoryBoost       = "Boost"
+	CategoryFuel        = "Fuel"
+	CategoryVehicle     = "Vehicle"
+	CategoryEnvironment = "Environment"
+	CategoryPlayer      = "Player"
+)
+
+// LookupItem resolves an item ID, such as Item_Weapon_HK416_C
+func LookupItem(itemID string) (Entry, bool) {
+	entry, ok := items[itemID]
+	return entry, ok
+}
+
+// LookupDamageCauser resolves a damage causer name, such as WeapHK416_C
+func LookupDamageCauser(damageCauserName string) (Entry, bool) {
+	entry, ok := damageCausers[damageCauserName]
+	return entry, ok
+}
+
+// LookupVehicle resolves a vehicle ID, such as Dacia_A_01_v2_C
+func LookupVehicle(vehicleID string) (Entry, bool) {
+	entry, ok := vehicles[vehicleID]
+	return entry, ok
+}
+
+// ItemName returns the name of an item, or its ID if the item is unknown
+func ItemName(itemID string) string {
+	if entry, ok := LookupItem(itemID); ok {
+		return entry.Name
+	}
+	return itemID
+}
+
+// DamageCauserName returns the name of a damage causer, or the damage causer
+// name itself if it is unknown
+func DamageCauserName(damageCauserName string) string {
+	if entry, ok := LookupDamageCauser(damageCauserName); ok {
+		return entry.Name
+	}
+	return damageCauserName
+}
+
+// VehicleName returns the name of a vehicle, or its ID if the vehicle is
+// unknown
+func VehicleName(vehicleID string) string {
+	if entry, ok := LookupVehicle(vehicleID); ok {
+		return entry.Name
+	}
+	return vehicleID
+}
@@ -0,0 +1,167 @@
+package dictionary
+
+// items maps item IDs, as found in telemetry items, to their names
+var items = map[string]Entry{
+	"Item_Ammo_12Guage_C":                                         {Name: "12 Gauge Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_12GuageSlug_C":                                     {Name: "12 Gauge Slug", Category: CategoryAmmunition},
+	"Item_Ammo_300Magnum_C":                                       {Name: ".300 Magnum Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_40mm_C":                                            {Name: "40mm Smoke Grenade", Category: CategoryAmmunition},
+	"Item_Ammo_45ACP_C":                                           {Name: ".45 ACP Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_556mm_C":                                           {Name: "5.56mm Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_57mm_C":                                            {Name: "5.7mm Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_762mm_C":                                           {Name: "7.62mm Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_9mm_C":                                             {Name: "9mm Ammo", Category: CategoryAmmunition},
+	"Item_Ammo_Bolt_C":                                            {Name: "Crossbow Bolt", Category: CategoryAmmunition},
+	"Item_Ammo_Flare_C":                                           {Name: "Flare", Category: CategoryAmmunition},
+	"Item_Ammo_Mortar_C":                                          {Name: "Mortar Shell", Category: CategoryAmmunition},
+	"Item_Armor_C_01_Lv3_C":                                       {Name: "Military Vest (Level 3)", Category: CategoryEquipment},
+	"Item_Armor_D_01_Lv2_C":                                       {Name: "Police Vest (Level 2)", Category: CategoryEquipment},
+	"Item_Armor_E_01_Lv1_C":                                       {Name: "Police Vest (Level 1)", Category: CategoryEquipment},
+	"Item_Attach_Weapon_Lower_AngledForeGrip_C":                   {Name: "Angled Foregrip", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Lower_Foregrip_C":                         {Name: "Vertical Foregrip", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Lower_HalfGrip_C":                         {Name: "Half Grip", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Lower_LaserPointer_C":                     {Name: "Laser Sight", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Lower_LightweightForeGrip_C":              {Name: "Light Grip", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Lower_ThumbGrip_C":                        {Name: "Thumb Grip", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_Extended_Large_C":                {Name: "Extended Mag (AR, DMR, S12K)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_Extended_Medium_C":               {Name: "Extended Mag (SMG)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_Extended_Small_C":                {Name: "Extended Mag (Handgun)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_Extended_SniperRifle_C":          {Name: "Extended Mag (DMR, SR)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_ExtendedQuickDraw_Large_C":       {Name: "Extended QuickDraw Mag (AR, DMR, S12K)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_ExtendedQuickDraw_Medium_C":      {Name: "Extended QuickDraw Mag (SMG)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_ExtendedQuickDraw_Small_C":       {Name: "Extended QuickDraw Mag (Handgun)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_ExtendedQuickDraw_SniperRifle_C": {Name: "Extended QuickDraw Mag (DMR, SR)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_QuickDraw_Large_C":               {Name: "QuickDraw Mag (AR, DMR, S12K)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_QuickDraw_Medium_C":              {Name: "QuickDraw Mag (SMG)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_QuickDraw_Small_C":               {Name: "QuickDraw Mag (Handgun)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Magazine_QuickDraw_SniperRifle_C":         {Name: "QuickDraw Mag (DMR, SR)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Choke_C":                           {Name: "Choke", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Compensator_Large_C":               {Name: "Compensator (AR, DMR, S12K)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Compensator_Medium_C":              {Name: "Compensator (SMG)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Compensator_SniperRifle_C":         {Name: "Compensator (DMR, SR)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Duckbill_C":                        {Name: "Duckbill", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_FlashHider_Large_C":                {Name: "Flash Hider (AR, DMR, S12K)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_FlashHider_Medium_C":               {Name: "Flash Hider (SMG)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_FlashHider_SniperRifle_C":          {Name: "Flash Hider (DMR, SR)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Suppressor_Large_C":                {Name: "Suppressor (AR, DMR, S12K)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Suppressor_Medium_C":               {Name: "Suppressor (SMG)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Suppressor_Small_C":                {Name: "Suppressor (Handgun)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Muzzle_Suppressor_SniperRifle_C":          {Name: "Suppressor (DMR, SR)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_SideRail_DotSight_RMR_C":                  {Name: "Canted Sight", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Stock_AR_Composite_C":                     {Name: "Tactical Stock", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Stock_AR_HeavyStock_C":                    {Name: "Heavy Stock", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Stock_SniperRifle_BulletLoops_C":          {Name: "Bullet Loops", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Stock_SniperRifle_CheekPad_C":             {Name: "Cheek Pad", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Stock_UZI_C":                              {Name: "Stock (Micro UZI)", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_ACOG_01_C":                          {Name: "4x ACOG Scope", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_Aimpoint_C":                         {Name: "2x Aimpoint Scope", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_CQBSS_C":                            {Name: "8x CQBSS Scope", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_DotSight_01_C":                      {Name: "Red Dot Sight", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_Holosight_C":                        {Name: "Holographic Sight", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_PM2_01_C":                           {Name: "15x PM II Scope", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_Scope3x_C":                          {Name: "3x Scope", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_Scope6x_C":                          {Name: "6x Scope", Category: CategoryAttachment},
+	"Item_Attach_Weapon_Upper_Thermal_C":                          {Name: "4x Thermal Scope", Category: CategoryAttachment},
+	"Item_Back_B_08_Lv3_C":                                        {Name: "Backpack (Level 3)", Category: CategoryEquipment},
+	"Item_Back_C_01_Lv3_C":                                        {Name: "Backpack (Level 3)", Category: CategoryEquipment},
+	"Item_Back_C_02_Lv3_C":                                        {Name: "Backpack (Level 3)", Category: CategoryEquipment},
+	"Item_Back_E_01_Lv1_C":                                        {Name: "Backpack (Level 1)", Category: CategoryEquipment},
+	"Item_Back_E_02_Lv1_C":                                        {Name: "Backpack (Level 1)", Category: CategoryEquipment},
+	"Item_Back_F_01_Lv2_C":                                        {Name: "Backpack (Level 2)", Category: CategoryEquipment},
+	"Item_Back_F_02_Lv2_C":                                        {Name: "Backpack (Level 2)", Category: CategoryEquipment},
+	"Item_Boost_AdrenalineSyringe_C":                              {Name: "Adrenaline Syringe", Category: CategoryBoost},
+	"Item_Boost_EnergyDrink_C":                                    {Name: "Energy Drink", Category: CategoryBoost},
+	"Item_Boost_PainKiller_C":                                     {Name: "Painkiller", Category: CategoryBoost},
+	"Item_BulletproofShield_C":                                    {Name: "Ballistic Shield", Category: CategoryEquipment},
+	"Item_Chimera_Key_C":                                          {Name: "Secret Room Key", Category: CategoryEquipment},
+	"Item_EmergencyPickup_C":                                      {Name: "Emergency Pickup", Category: CategoryEquipment},
+	"Item_Fuel_JerryCan_C":                                        {Name: "Gas Can", Category: CategoryFuel},
+	"Item_Ghillie_01_C":                                           {Name: "Ghillie Suit", Category: CategoryEquipment},
+	"Item_Ghillie_02_C":                                           {Name: "Ghillie Suit", Category: CategoryEquipment},
+	"Item_Ghillie_03_C":                                           {Name: "Ghillie Suit", Category: CategoryEquipment},
+	"Item_Ghillie_04_C":                                           {Name: "Ghillie Suit", Category: CategoryEquipment},
+	"Item_Head_E_01_Lv1_C":                                        {Name: "Motorcycle Helmet (Level 1)", Category: CategoryEquipment},
+	"Item_Head_E_02_Lv1_C":                                        {Name: "Motorcycle Helmet (Level 1)", Category: CategoryEquipment},
+	"Item_Head_F_01_Lv2_C":                                        {Name: "Military Helmet (Level 2)", Category: CategoryEquipment},
+	"Item_Head_F_02_Lv2_C":                                        {Name: "Military Helmet (Level 2)", Category: CategoryEquipment},
+	"Item_Head_G_01_Lv3_C":                                        {Name: "Spetsnaz Helmet (Level 3)", Category: CategoryEquipment},
+	"Item_Heal_Bandage_C":                                         {Name: "Bandage", Category: CategoryHeal},
+	"Item_Heal_FirstAid_C":                                        {Name: "First Aid Kit", Category: CategoryHeal},
+	"Item_Heal_MedKit_C":                                          {Name: "Med Kit", Category: CategoryHeal},
+	"Item_Heaven_Key_C":                                           {Name: "Secret Room Key", Category: CategoryEquipment},
+	"Item_JerryCan_C":                                             {Name: "Gas Can", Category: CategoryEquipment},
+	"Item_Kiki_Key_C":                                             {Name: "Secret Room Key", Category: CategoryEquipment},
+	"Item_Mountainbike_C":                                         {Name: "Folded Mountain Bike", Category: CategoryEquipment},
+	"Item_Tiger_Key_C":                                            {Name: "Secret Room Key", Category: CategoryEquipment},
+	"Item_Tiger_SelfRevive_C":                                     {Name: "Self-AED", Category: CategoryEquipment},
+	"Item_Weapon_ACE32_C":                                         {Name: "ACE32", Category: CategoryWeapon},
+	"Item_Weapon_AK47_C":                                          {Name: "AKM", Category: CategoryWeapon},
+	"Item_Weapon_AUG_C":                                           {Name: "AUG A3", Category: CategoryWeapon},
+	"Item_Weapon_AWM_C":                                           {Name: "AWM", Category: CategoryWeapon},
+	"Item_Weapon_Berreta686_C":                                    {Name: "S686", Category: CategoryWeapon},
+	"Item_Weapon_BerylM762_C":                                     {Name: "Beryl M762", Category: CategoryWeapon},
+	"Item_Weapon_BizonPP19_C":                                     {Name: "PP-19 Bizon", Category: CategoryWeapon},
+	"Item_Weapon_BluezoneGrenade_C":                               {Name: "Bluezone Grenade", Category: CategoryThrowable},
+	"Item_Weapon_C4_C":                                            {Name: "C4", Category: CategoryThrowable},
+	"Item_Weapon_Cowbar_C":                                        {Name: "Crowbar", Category: CategoryMelee},
+	"Item_Weapon_Crossbow_C":                                      {Name: "Crossbow", Category: CategoryWeapon},
+	"Item_Weapon_DecoyGrenade_C":                                  {Name: "Decoy Grenade", Category: CategoryThrowable},
+	"Item_Weapon_DesertEagle_C":                                   {Name: "Deagle", Category: CategoryWeapon},
+	"Item_Weapon_DP12_C":                                          {Name: "DBS", Category: CategoryWeapon},
+	"Item_Weapon_DP28_C":                                          {Name: "DP-28", Category: CategoryWeapon},
+	"Item_Weapon_Dragunov_C":                                      {Name: "Dragunov", Category: CategoryWeapon},
+	"Item_Weapon_FAMASG2_C":                                       {Name: "FAMAS", Category: CategoryWeapon},
+	"Item_Weapon_FlareGun_C":                                      {Name: "Flare Gun", Category: CategoryWeapon},
+	"Item_Weapon_FlashBang_C":                                     {Name: "Stun Grenade", Category: CategoryThrowable},
+	"Item_Weapon_FNFal_C":                                         {Name: "SLR", Category: CategoryWeapon},
+	"Item_Weapon_G18_C":                                           {Name: "P18C", Category: CategoryWeapon},
+	"Item_Weapon_G36C_C":                                          {Name: "G36C", Category: CategoryWeapon},
+	"Item_Weapon_Grenade_C":                                       {Name: "Frag Grenade", Category: CategoryThrowable},
+	"Item_Weapon_Groza_C":                                         {Name: "Groza", Category: CategoryWeapon},
+	"Item_Weapon_HK416_C":                                         {Name: "M416", Category: CategoryWeapon},
+	"Item_Weapon_JS9_C":                                           {Name: "JS9", Category: CategoryWeapon},
+	"Item_Weapon_K2_C":                                            {Name: "K2", Category: CategoryWeapon},
+	"Item_Weapon_Kar98k_C":                                        {Name: "Kar98k", Category: CategoryWeapon},
+	"Item_Weapon_L6_C":                                            {Name: "Lynx AMR", Category: CategoryWeapon},
+	"Item_Weapon_M16A4_C":                                         {Name: "M16A4", Category: CategoryWeapon},
+	"Item_Weapon_M1911_C":                                         {Name: "P1911", Category: CategoryWeapon},
+	"Item_Weapon_M249_C":                                          {Name: "M249", Category: CategoryWeapon},
+	"Item_Weapon_M24_C":                                           {Name: "M24", Category: CategoryWeapon},
+	"Item_Weapon_M9_C":                                            {Name: "P92", Category: CategoryWeapon},
+	"Item_Weapon_Machete_C":                                       {Name: "Machete", Category: CategoryMelee},
+	"Item_Weapon_MG3_C":                                           {Name: "MG3", Category: CategoryWeapon},
+	"Item_Weapon_Mini14_C":                                        {Name: "Mini 14", Category: CategoryWeapon},
+	"Item_Weapon_Mk12_C":                                          {Name: "Mk12", Category: CategoryWeapon},
+	"Item_Weapon_Mk14_C":                                          {Name: "Mk14 EBR", Category: CategoryWeapon},
+	"Item_Weapon_Mk47Mutant_C":                                    {Name: "Mk47 Mutant", Category: CategoryWeapon},
+	"Item_Weapon_Molotov_C":                                       {Name: "Molotov Cocktail", Category: CategoryThrowable},
+	"Item_Weapon_Mortar_C":                                        {Name: "Mortar", Category: CategoryWeapon},
+	"Item_Weapon_Mosin_C":                                         {Name: "Mosin-Nagant", Category: CategoryWeapon},
+	"Item_Weapon_MP5K_C":                                          {Name: "MP5K", Category: CategoryWeapon},
+	"Item_Weapon_MP9_C":                                           {Name: "MP9", Category: CategoryWeapon},
+	"Item_Weapon_NagantM1895_C":                                   {Name: "R1895", Category: CategoryWeapon},
+	"Item_Weapon_OriginS12_C":                                     {Name: "O12", Category: CategoryWeapon},
+	"Item_Weapon_P90_C":                                           {Name: "P90", Category: CategoryWeapon},
+	"Item_Weapon_Pan_C":                                           {Name: "Pan", Category: CategoryMelee},
+	"Item_Weapon_PanzerFaust100M_C":                               {Name: "Panzerfaust", Category: CategoryWeapon},
+	"Item_Weapon_QBU88_C":                                         {Name: "QBU", Category: CategoryWeapon},
+	"Item_Weapon_QBZ95_C":                                         {Name: "QBZ", Category: CategoryWeapon},
+	"Item_Weapon_Rhino_C":                                         {Name: "R45", Category: CategoryWeapon},
+	"Item_Weapon_Saiga12_C":                                       {Name: "S12K", Category: CategoryWeapon},
+	"Item_Weapon_Sawnoff_C":                                       {Name: "Sawed-off", Category: CategoryWeapon},
+	"Item_Weapon_SCAR-L_C":                                        {Name: "SCAR-L", Category: CategoryWeapon},
+	"Item_Weapon_Sickle_C":                                        {Name: "Sickle", Category: CategoryMelee},
+	"Item_Weapon_SKS_C":                                           {Name: "SKS", Category: CategoryWeapon},
+	"Item_Weapon_SmokeBomb_C":                                     {Name: "Smoke Grenade", Category: CategoryThrowable},
+	"Item_Weapon_SpikeTrap_C":                                     {Name: "Spike Trap", Category: CategoryThrowable},
+	"Item_Weapon_StickyGrenade_C":                                 {Name: "Sticky Bomb", Category: CategoryThrowable},
+	"Item_Weapon_StunGun_C":                                       {Name: "Stun Gun", Category: CategoryWeapon},
+	"Item_Weapon_Thompson_C":                                      {Name: "Tommy Gun", Category: CategoryWeapon},
+	"Item_Weapon_UMP_C":                                           {Name: "UMP45", Category: CategoryWeapon},
+	"Item_Weapon_UZI_C":                                           {Name: "Micro UZI", Category: CategoryWeapon},
+	"Item_Weapon_Vector_C":                                        {Name: "Vector", Category: CategoryWeapon},
+	"Item_Weapon_VSS_C":                                           {Name: "VSS", Category: CategoryWeapon},
+	"Item_Weapon_vz61Skorpion_C":                                  {Name: "Skorpion", Category: CategoryWeapon},
+	"Item_Weapon_Win1894_C":                                       {Name: "Win94", Category: CategoryWeapon},
+	"Item_Weapon_Winchester_C":                                    {Name: "S1897", Category: CategoryWeapon},
+}
@@ -0,0 +1,57 @@
+package dictionary
+
+// vehicles maps vehicle IDs, as found in telemetry vehicles, to their names
+var vehicles = map[string]Entry{
+	"AquaRail_A_01_C":                  {Name: "Aquarail", Category: CategoryVehicle},
+	"AquaRail_A_02_C":                  {Name: "Aquarail", Category: CategoryVehicle},
+	"AquaRail_A_03_C":                  {Name: "Aquarail", Category: CategoryVehicle},
+	"Boat_PG117_C":                     {Name: "PG-117", Category: CategoryVehicle},
+	"BP_ATV_C":                         {Name: "Quad", Category: CategoryVehicle},
+	"BP_Bicycle_C":                     {Name: "Mountain Bike", Category: CategoryVehicle},
+	"BP_Blanc_C":                       {Name: "Blanc", Category: CategoryVehicle},
+	"BP_BRDM_C":                        {Name: "BRDM-2", Category: CategoryVehicle},
+	"BP_CoupeRB_C":                     {Name: "Coupe RB", Category: CategoryVehicle},
+	"BP_Dirtbike_C":                    {Name: "Dirt Bike", Category: CategoryVehicle},
+	"BP_EmergencyPickupVehicle_C":      {Name: "Emergency Pickup", Category: CategoryVehicle},
+	"BP_KillTruck_C":                   {Name: "Kill Truck", Category: CategoryVehicle},
+	"BP_LootTruck_C":                   {Name: "Loot Truck", Category: CategoryVehicle},
+	"BP_M_Rony_A_01_C":                 {Name: "Rony", Category: CategoryVehicle},
+	"BP_M_Rony_A_02_C":                 {Name: "Rony", Category: CategoryVehicle},
+	"BP_M_Rony_A_03_C":                 {Name: "Rony", Category: CategoryVehicle},
+	"BP_Mirado_A_01_C":                 {Name: "Mirado", Category: CategoryVehicle},
+	"BP_Mirado_A_02_C":                 {Name: "Mirado", Category: CategoryVehicle},
+	"BP_Mirado_Open_03_C":              {Name: "Mirado (open top)", Category: CategoryVehicle},
+	"BP_Motorbike_04_C":                {Name: "Motorcycle", Category: CategoryVehicle},
+	"BP_Motorbike_04_Desert_C":         {Name: "Motorcycle", Category: CategoryVehicle},
+	"BP_Motorbike_04_SideCar_C":        {Name: "Motorcycle (w/ Sidecar)", Category: CategoryVehicle},
+	"BP_Motorbike_04_SideCar_Desert_C": {Name: "Motorcycle (w/ Sidecar)", Category: CategoryVehicle},
+	"BP_Motorglider_C":                 {Name: "Motor Glider", Category: CategoryVehicle},
+	"BP_Niva_01_C":                     {Name: "Zima", Category: CategoryVehicle},
+	"BP_Niva_02_C":                     {Name: "Zima", Category: CategoryVehicle},
+	"BP_PickupTruck_A_01_C":            {Name: "Pickup Truck (closed top)", Category: CategoryVehicle},
+	"BP_PickupTruck_B_01_C":            {Name: "Pickup Truck (open top)", Category: CategoryVehicle},
+	"BP_PonyCoupe_C":                   {Name: "Pony Coupe", Category: CategoryVehicle},
+	"BP_Porter_C":                      {Name: "Porter", Category: CategoryVehicle},
+	"BP_Scooter_01_A_C":                {Name: "Scooter", Category: CategoryVehicle},
+	"BP_Scooter_02_A_C":                {Name: "Scooter", Category: CategoryVehicle},
+	"BP_Snowbike_01_C":                 {Name: "Snowbike", Category: CategoryVehicle},
+	"BP_Snowmobile_01_C":               {Name: "Snowmobile", Category: CategoryVehicle},
+	"BP_TukTukTuk_A_01_C":              {Name: "Tukshai", Category: CategoryVehicle},
+	"BP_Van_A_01_C":                    {Name: "Van", Category: CategoryVehicle},
+	"BP_Van_A_02_C":                    {Name: "Van", Category: CategoryVehicle},
+	"Buggy_A_01_C":                     {Name: "Buggy", Category: CategoryVehicle},
+	"Buggy_A_02_C":                     {Name: "Buggy", Category: CategoryVehicle},
+	"Buggy_A_03_C":                     {Name: "Buggy", Category: CategoryVehicle},
+	"Dacia_A_01_v2_C":                  {Name: "Dacia", Category: CategoryVehicle},
+	"Dacia_A_02_v2_C":                  {Name: "Dacia", Category: CategoryVehicle},
+	"Dacia_A_03_v2_C":                  {Name: "Dacia", Category: CategoryVehicle},
+	"Dacia_A_04_v2_C":                  {Name: "Dacia", Category: CategoryVehicle},
+	"DummyTransportAircraft_C":         {Name: "C-130", Category: CategoryVehicle},
+	"EmergencyAircraft_Tiger_C":        {Name: "Emergency Aircraft", Category: CategoryVehicle},
+	"ParachutePlayer_C":                {Name: "Parachute", Category: CategoryVehicle},
+	"TransportAircraft_Chimera_C":      {Name: "Helicopter", Category: CategoryVehicle},
+	"TransportAircraft_Tiger_C":        {Name: "C-130", Category: CategoryVehicle},
+	"Uaz_A_01_C":                       {Name: "UAZ (open top)", Category: CategoryVehicle},
+	"Uaz_B_01_C":                       {Name: "UAZ (soft top)", Category: CategoryVehicle},
+	"Uaz_C_01_C":                       {Name: "UAZ (hard top)", Category: CategoryVehicle},
+}
@@ -0,0 +1,58 @@
+package leaderboard
+
+import (
+	"io"
+	"sort"
+
+	"github.com/driquet/gopubg/models/pubg"
+	"github.com/slemgrim/jsonapi"
+)
+
+// Leaderboard structure represents a page of the leaderboard of a game mode
+// during a season
+type Leaderboard struct {
+	ID          string    `jsonapi:"primary,leaderboard"`
+	ShardID     string    `jsonapi:"attr,shardId"`
+	RawGameMode string    `jsonapi:"attr,gameMode"`
+	SeasonID    string    `jsonapi:"attr,seasonId"`
+	Players     []*Player `jsonapi:"relation,players"`
+
+	// GameMode is the typed form of RawGameMode, set by ParseLeaderboard
+	// since jsonapi only assigns attributes to plain strings
+	GameMode pubg.GameMode
+}
+
+// Player structure represents an entry of a leaderboard
+type Player struct {
+	ID    string `jsonapi:"primary,player"`
+	Name  string `jsonapi:"attr,name"`
+	Rank  int    `jsonapi:"attr,rank"`
+	Stats struct {
+		RankPoints     float64 `json:"rankPoints"`
+		Tier           string  `json:"tier"`
+		SubTier        string  `json:"subTier"`
+		Games          int     `json:"games"`
+		Wins           int     `json:"wins"`
+		WinRatio       float64 `json:"winRatio"`
+		Kills          int     `json:"kills"`
+		KillDeathRatio float64 `json:"killDeathRatio"`
+		KDA            float64 `json:"kda"`
+		AverageDamage  float64 `json:"averageDamage"`
+		AverageRank    float64 `json:"averageRank"`
+	} `jsonapi:"attr,stats"`
+}
+
+// ParseLeaderboard parses a json response containing a page of a leaderboard.
+// Players are sorted by rank.
+func ParseLeaderboard(in io.Reader) (*Leaderboard, error) {
+	leaderboard := new(Leaderboard)
+	if err := jsonapi.UnmarshalPayload(in, leaderboard); err != nil {
+		return nil, err
+	}
+	leaderboard.GameMode = pubg.GameMode(leaderboard.RawGameMode)
+
+	sort.Slice(leaderboard.Players, func(i, j int) bool {
+		return leaderboard.Players[i].Rank < leaderboard.Players[j].Rank
+	})
+	return leaderboard, nil
+}
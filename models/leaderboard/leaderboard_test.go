@@ -0,0 +1,65 @@
+package leaderboard
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/driquet/gopubg/models/pubg"
+)
+
+func TestParseLeaderboard(t *testing.T) {
+	input := `{
+		"data": {
+			"type": "leaderboard",
+			"id": "leaderboard",
+			"attributes": {
+				"shardId": "pc-eu",
+				"gameMode": "squad-fpp",
+				"seasonId": "division.bro.official.pc-2018-18"
+			},
+			"relationships": {
+				"players": {
+					"data": [
+						{"type": "player", "id": "account.b"},
+						{"type": "player", "id": "account.a"}
+					]
+				}
+			}
+		},
+		"included": [
+			{
+				"type": "player",
+				"id": "account.b",
+				"attributes": {"name": "b", "rank": 2, "stats": {"rankPoints": 4200.5, "tier": "Master", "games": 80, "kills": 300}}
+			},
+			{
+				"type": "player",
+				"id": "account.a",
+				"attributes": {"name": "a", "rank": 1, "stats": {"rankPoints": 4500, "tier": "Master", "games": 90, "kills": 350}}
+			}
+		]
+	}`
+
+	leaderboard, err := ParseLeaderboard(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if leaderboard.ShardID != "pc-eu" || leaderboard.GameMode != pubg.GameModeSquadFPP || leaderboard.SeasonID != "division.bro.official.pc-2018-18" {
+		t.Errorf("unexpected attributes %+v", leaderboard)
+	}
+	if len(leaderboard.Players) != 2 {
+		t.Fatalf("expected 2 players, got %d", len(leaderboard.Players))
+	}
+
+	first := leaderboard.Players[0]
+	if first.ID != "account.a" || first.Name != "a" || first.Rank != 1 {
+		t.Errorf("players are not sorted by rank: %+v", first)
+	}
+	if first.Stats.RankPoints != 4500 || first.Stats.Tier != "Master" || first.Stats.Kills != 350 {
+		t.Errorf("unexpected stats %+v", first.Stats)
+	}
+	if leaderboard.Players[1].Stats.RankPoints != 4200.5 {
+		t.Errorf("unexpected stats %+v", leaderboard.Players[1].Stats)
+	}
+}
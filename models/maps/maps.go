@@ -0,0 +1,50 @@
+package maps
+
+// Map structure represents a PUBG map
+type Map struct {
+	// Name is the internal name of the map, such as Baltic_Main
+	Name string
+	// DisplayName is the name of the map shown to players, such as Erangel
+	DisplayName string
+	// Size is the width, and height, of the world in centimeters. Telemetry
+	// locations range from 0 to Size on both axes.
+	Size float64
+}
+
+// KnownMaps represents supported maps
+var KnownMaps = []*Map{
+	{Name: "Baltic_Main", DisplayName: "Erangel", Size: 816000},
+	{Name: "Erangel_Main", DisplayName: "Erangel", Size: 816000},
+	{Name: "Desert_Main", DisplayName: "Miramar", Size: 816000},
+	{Name: "Savage_Main", DisplayName: "Sanhok", Size: 408000},
+	{Name: "DihorOtok_Main", DisplayName: "Vikendi", Size: 816000},
+	{Name: "Summerland_Main", DisplayName: "Karakin", Size: 204000},
+	{Name: "Chimera_Main", DisplayName: "Paramo", Size: 306000},
+	{Name: "Heaven_Main", DisplayName: "Haven", Size: 102000},
+	{Name: "Tiger_Main", DisplayName: "Taego", Size: 816000},
+	{Name: "Kiki_Main", DisplayName: "Deston", Size: 816000},
+	{Name: "Range_Main", DisplayName: "Camp Jackal", Size: 204000},
+	{Name: "Neon_Main", DisplayName: "Rondo", Size: 816000},
+}
+
+// Lookup finds a map by its internal name, it returns nil if the map is
+// unknown
+func Lookup(name string) *Map {
+	for _, m := range KnownMaps {
+		if m.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// Normalize converts a world position, in centimeters, to a position relative
+// to the size of the map: (0, 0) is the top left corner of the map and (1, 1)
+// its bottom right corner. A nil map, such as an unknown map returned by Lookup,
+// normalizes every position to (0, 0).
+func (m *Map) Normalize(x, y float64) (float64, float64) {
+	if m == nil || m.Size == 0 {
+		return 0, 0
+	}
+	return x / m.Size, y / m.Size
+}
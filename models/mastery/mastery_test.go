@@ -0,0 +1,92 @@
+package mastery
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseWeaponMastery(t *testing.T) {
+	input := `{
+		"data": {
+			"type": "weaponMasterySummary",
+			"id": "account.a",
+			"attributes": {
+				"platform": "steam",
+				"seasonId": "division.bro.official.pc-2018-18",
+				"latestMatchId": "match",
+				"weaponSummaries": {
+					"Item_Weapon_HK416_C": {
+						"XPTotal": 1200,
+						"LevelCurrent": 12,
+						"TierCurrent": 1,
+						"StatsTotal": {"Kills": 42, "HeadShots": 7, "LongestDefeat": 312.5},
+						"Medals": [{"MedalId": "Medal_LongShot", "Count": 2}]
+					},
+					"Item_Weapon_AK47_C": {
+						"XPTotal": 300,
+						"StatsTotal": {"Kills": 3}
+					}
+				}
+			}
+		}
+	}`
+
+	weaponMastery, err := ParseWeaponMastery(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if weaponMastery.ID != "account.a" || weaponMastery.Platform != "steam" || weaponMastery.LatestMatchID != "match" {
+		t.Errorf("unexpected attributes %+v", weaponMastery)
+	}
+	if len(weaponMastery.WeaponSummaries.Weapons) != 2 {
+		t.Fatalf("expected 2 weapons, got %d", len(weaponMastery.WeaponSummaries.Weapons))
+	}
+
+	summary := weaponMastery.WeaponSummaries.Weapons["Item_Weapon_HK416_C"]
+	if summary == nil {
+		t.Fatal("missing Item_Weapon_HK416_C summary")
+	}
+	if summary.XPTotal != 1200 || summary.LevelCurrent != 12 || summary.StatsTotal.Kills != 42 || summary.StatsTotal.LongestDefeat != 312.5 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+	if len(summary.Medals) != 1 || summary.Medals[0].MedalID != "Medal_LongShot" || summary.Medals[0].Count != 2 {
+		t.Errorf("unexpected medals %+v", summary.Medals)
+	}
+}
+
+func TestParseSurvivalMastery(t *testing.T) {
+	input := `{
+		"data": {
+			"type": "survivalMasterySummary",
+			"id": "account.a",
+			"attributes": {
+				"xp": 5000,
+				"tier": 2,
+				"level": 37,
+				"totalMatchesPlayed": 120,
+				"latestMatchId": "match",
+				"stats": {
+					"damageDealt": {"total": 15000, "average": 125, "careerBest": 980.5, "lastMatchValue": 42},
+					"top10": {"total": 48}
+				}
+			}
+		}
+	}`
+
+	survivalMastery, err := ParseSurvivalMastery(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if survivalMastery.XP != 5000 || survivalMastery.Level != 37 || survivalMastery.TotalMatchesPlayed != 120 {
+		t.Errorf("unexpected attributes %+v", survivalMastery)
+	}
+	damageDealt := survivalMastery.Stats.DamageDealt
+	if damageDealt.Total != 15000 || damageDealt.Average != 125 || damageDealt.CareerBest != 980.5 || damageDealt.LastMatchValue != 42 {
+		t.Errorf("unexpected damage dealt %+v", damageDealt)
+	}
+	if survivalMastery.Stats.Top10.Total != 48 {
+		t.Errorf("expected 48 top 10, got %v", survivalMastery.Stats.Top10.Total)
+	}
+}
@@ -0,0 +1,56 @@
+package mastery
+
+import (
+	"io"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// SurvivalMastery structure represents the survival mastery of a player
+type SurvivalMastery struct {
+	ID                 string        `jsonapi:"primary,survivalMasterySummary"`
+	XP                 int           `jsonapi:"attr,xp"`
+	Tier               int           `jsonapi:"attr,tier"`
+	Level              int           `jsonapi:"attr,level"`
+	TotalMatchesPlayed int           `jsonapi:"attr,totalMatchesPlayed"`
+	LatestMatchID      string        `jsonapi:"attr,latestMatchId"`
+	Stats              SurvivalStats `jsonapi:"attr,stats"`
+}
+
+// SurvivalStats structure represents the survival stats of a player
+type SurvivalStats struct {
+	AirDropsCalled     SurvivalStat `json:"airDropsCalled"`
+	DamageDealt        SurvivalStat `json:"damageDealt"`
+	DamageTaken        SurvivalStat `json:"damageTaken"`
+	DistanceBySwimming SurvivalStat `json:"distanceBySwimming"`
+	DistanceByVehicle  SurvivalStat `json:"distanceByVehicle"`
+	DistanceOnFoot     SurvivalStat `json:"distanceOnFoot"`
+	DistanceTotal      SurvivalStat `json:"distanceTotal"`
+	EnemyCratesLooted  SurvivalStat `json:"enemyCratesLooted"`
+	Healed             SurvivalStat `json:"healed"`
+	HotDropLandings    SurvivalStat `json:"hotDropLandings"`
+	Position           SurvivalStat `json:"position"`
+	Revived            SurvivalStat `json:"revived"`
+	TeammatesRevived   SurvivalStat `json:"teammatesRevived"`
+	ThrowablesThrown   SurvivalStat `json:"throwablesThrown"`
+	TimeSurvived       SurvivalStat `json:"timeSurvived"`
+	Top10              SurvivalStat `json:"top10"`
+}
+
+// SurvivalStat structure represents the values of a survival stat
+type SurvivalStat struct {
+	Total          float64 `json:"total"`
+	Average        float64 `json:"average"`
+	CareerBest     float64 `json:"careerBest"`
+	LastMatchValue float64 `json:"lastMatchValue"`
+}
+
+// ParseSurvivalMastery parses a json response containing the survival mastery
+// of a player
+func ParseSurvivalMastery(in io.Reader) (*SurvivalMastery, error) {
+	survivalMastery := new(SurvivalMastery)
+	if err := jsonapi.UnmarshalPayload(in, survivalMastery); err != nil {
+		return nil, err
+	}
+	return survivalMastery, nil
+}
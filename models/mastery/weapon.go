@@ -0,0 +1,72 @@
+package mastery
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// WeaponMastery structure represents the weapon mastery of a player
+type WeaponMastery struct {
+	ID              string          `jsonapi:"primary,weaponMasterySummary"`
+	Platform        string          `jsonapi:"attr,platform"`
+	SeasonID        string          `jsonapi:"attr,seasonId"`
+	LatestMatchID   string          `jsonapi:"attr,latestMatchId"`
+	WeaponSummaries WeaponSummaries `jsonapi:"attr,weaponSummaries"`
+}
+
+// WeaponSummaries holds the mastery of each weapon, indexed by item ID (such
+// as Item_Weapon_HK416_C)
+type WeaponSummaries struct {
+	Weapons map[string]*WeaponSummary
+}
+
+// UnmarshalJSON decodes the weapon summaries, which the API sends as an
+// object keyed by item ID
+func (w *WeaponSummaries) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &w.Weapons)
+}
+
+// WeaponSummary structure represents the mastery of a weapon
+type WeaponSummary struct {
+	XPTotal               int         `json:"XPTotal"`
+	LevelCurrent          int         `json:"LevelCurrent"`
+	TierCurrent           int         `json:"TierCurrent"`
+	StatsTotal            WeaponStats `json:"StatsTotal"`
+	OfficialStatsTotal    WeaponStats `json:"OfficialStatsTotal"`
+	CompetitiveStatsTotal WeaponStats `json:"CompetitiveStatsTotal"`
+	Medals                []*Medal    `json:"Medals"`
+}
+
+// WeaponStats structure represents the stats of a player with a weapon
+type WeaponStats struct {
+	Kills                   int     `json:"Kills"`
+	MostKillsInAGame        int     `json:"MostKillsInAGame"`
+	Defeats                 int     `json:"Defeats"`
+	MostDefeatsInAGame      int     `json:"MostDefeatsInAGame"`
+	DamagePlayer            float64 `json:"DamagePlayer"`
+	MostDamagePlayerInAGame float64 `json:"MostDamagePlayerInAGame"`
+	Groggies                int     `json:"Groggies"`
+	MostGroggiesInAGame     int     `json:"MostGroggiesInAGame"`
+	HeadShots               int     `json:"HeadShots"`
+	MostHeadShotsInAGame    int     `json:"MostHeadShotsInAGame"`
+	LongestDefeat           float64 `json:"LongestDefeat"`
+	LongRangeDefeats        int     `json:"LongRangeDefeats"`
+}
+
+// Medal structure represents a medal earned with a weapon
+type Medal struct {
+	MedalID string `json:"MedalId"`
+	Count   int    `json:"Count"`
+}
+
+// ParseWeaponMastery parses a json response containing the weapon mastery of
+// a player
+func ParseWeaponMastery(in io.Reader) (*WeaponMastery, error) {
+	weaponMastery := new(WeaponMastery)
+	if err := jsonapi.UnmarshalPayload(in, weaponMastery); err != nil {
+		return nil, err
+	}
+	return weaponMastery, nil
+}
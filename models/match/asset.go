@@ -0,0 +1,12 @@
+package match
+
+import "time"
+
+// Asset represents a file related to a match, such as its telemetry
+type Asset struct {
+	ID          string    `jsonapi:"primary,asset"`
+	URL         string    `jsonapi:"attr,URL"`
+	Name        string    `jsonapi:"attr,name"`
+	Description string    `jsonapi:"attr,description"`
+	CreatedAt   time.Time `jsonapi:"attr,createdAt,iso8601"`
+}
@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/driquet/gopubg/models/maps"
+	"github.com/driquet/gopubg/models/pubg"
 	"github.com/slemgrim/jsonapi"
 )
 
@@ -14,12 +16,45 @@ type Match struct {
 	ID           string    `jsonapi:"primary,match"`
 	CreatedAt    time.Time `jsonapi:"attr,createdAt,iso8601"`
 	Duration     int       `jsonapi:"attr,duration"`
-	GameMode     string    `jsonapi:"attr,gameMode"`
+	RawGameMode  string    `jsonapi:"attr,gameMode"`
+	MapName      string    `jsonapi:"attr,mapName"`
 	PatchVersion string    `jsonapi:"attr,patchVersion"`
 	ShardID      string    `jsonapi:"attr,shardId"`
 	TitleID      string    `jsonapi:"attr,titleId"`
 	Rosters      []*Roster `jsonapi:"relation,rosters"`
-	// Todo stats, tags, assets, rounds, spectators
+	Assets       []*Asset  `jsonapi:"relation,assets"`
+	// Todo stats, tags, rounds, spectators
+
+	// GameMode is the typed form of RawGameMode, set by the parse functions
+	// since jsonapi only assigns attributes to plain strings
+	GameMode pubg.GameMode
+}
+
+// Map returns the map the match was played on, or nil if the map is unknown
+func (m *Match) Map() *maps.Map {
+	return maps.Lookup(m.MapName)
+}
+
+// TelemetryURL returns the URL of the telemetry file of the match, or an
+// empty string if the match has no telemetry asset
+func (m *Match) TelemetryURL() string {
+	for _, asset := range m.Assets {
+		if asset.Name == "telemetry" {
+			return asset.URL
+		}
+	}
+	return ""
+}
+
+// ParseSingleMatch parses a json response containing a single match, along
+// with its included rosters and participants
+func ParseSingleMatch(in io.Reader) (*Match, error) {
+	match := new(Match)
+	if err := jsonapi.UnmarshalPayload(in, match); err != nil {
+		return nil, err
+	}
+	match.GameMode = pubg.GameMode(match.RawGameMode)
+	return match, nil
 }
 
 // ParseMatch parses a json response containing matches information
@@ -35,6 +70,7 @@ func ParseMatch(in io.Reader) ([]*Match, error) {
 		if !ok {
 			return nil, errors.New("Failed to convert matches")
 		}
+		match.GameMode = pubg.GameMode(match.RawGameMode)
 		matches[idx] = match
 	}
 	return matches, nil
@@ -11,16 +11,27 @@ import (
 
 // Player structure represents a player entry
 type Player struct {
-	ID           string    `json:"primary,player"`
+	ID           string    `jsonapi:"primary,player"`
 	Name         string    `jsonapi:"attr,name"`
 	ShardID      string    `jsonapi:"attr,shardId"`
 	CreatedAt    time.Time `jsonapi:"attr,createdAt,iso8601"`
 	UpdatedAt    time.Time `jsonapi:"attr,updatedAt,iso8601"`
 	PatchVersion string    `jsonapi:"attr,patchVersion"`
 	TitleID      string    `jsonapi:"attr,titleId"`
+	ClanID       string    `jsonapi:"attr,clanId"`
 	Matches      []*Match  `jsonapi:"relation,matches"`
 }
 
+// GroupByClan groups players by clan ID. Players that are not part of a clan
+// are grouped under an empty clan ID.
+func GroupByClan(players []*Player) map[string][]*Player {
+	clans := make(map[string][]*Player)
+	for _, player := range players {
+		clans[player.ClanID] = append(clans[player.ClanID], player)
+	}
+	return clans
+}
+
 // Match structure represent data related to a PUBG match
 type Match struct {
 	ID     string `jsonapi:"primary,match"`
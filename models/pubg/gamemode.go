@@ -0,0 +1,145 @@
+package pubg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GameMode represents the game mode of a match, such as match.Match.GameMode
+type GameMode string
+
+// Game modes
+const (
+	GameModeSolo     GameMode = "solo"
+	GameModeSoloFPP  GameMode = "solo-fpp"
+	GameModeDuo      GameMode = "duo"
+	GameModeDuoFPP   GameMode = "duo-fpp"
+	GameModeSquad    GameMode = "squad"
+	GameModeSquadFPP GameMode = "squad-fpp"
+)
+
+// Custom and event game modes
+const (
+	GameModeNormalSolo       GameMode = "normal-solo"
+	GameModeNormalSoloFPP    GameMode = "normal-solo-fpp"
+	GameModeNormalDuo        GameMode = "normal-duo"
+	GameModeNormalDuoFPP     GameMode = "normal-duo-fpp"
+	GameModeNormalSquad      GameMode = "normal-squad"
+	GameModeNormalSquadFPP   GameMode = "normal-squad-fpp"
+	GameModeEsportsSolo      GameMode = "esports-solo"
+	GameModeEsportsSoloFPP   GameMode = "esports-solo-fpp"
+	GameModeEsportsDuo       GameMode = "esports-duo"
+	GameModeEsportsDuoFPP    GameMode = "esports-duo-fpp"
+	GameModeEsportsSquad     GameMode = "esports-squad"
+	GameModeEsportsSquadFPP  GameMode = "esports-squad-fpp"
+	GameModeConquestSolo     GameMode = "conquest-solo"
+	GameModeConquestSoloFPP  GameMode = "conquest-solo-fpp"
+	GameModeConquestDuo      GameMode = "conquest-duo"
+	GameModeConquestDuoFPP   GameMode = "conquest-duo-fpp"
+	GameModeConquestSquad    GameMode = "conquest-squad"
+	GameModeConquestSquadFPP GameMode = "conquest-squad-fpp"
+	GameModeWarSolo          GameMode = "war-solo"
+	GameModeWarSoloFPP       GameMode = "war-solo-fpp"
+	GameModeWarDuo           GameMode = "war-duo"
+	GameModeWarDuoFPP        GameMode = "war-duo-fpp"
+	GameModeWarSquad         GameMode = "war-squad"
+	GameModeWarSquadFPP      GameMode = "war-squad-fpp"
+	GameModeZombieSolo       GameMode = "zombie-solo"
+	GameModeZombieSoloFPP    GameMode = "zombie-solo-fpp"
+	GameModeZombieDuo        GameMode = "zombie-duo"
+	GameModeZombieDuoFPP     GameMode = "zombie-duo-fpp"
+	GameModeZombieSquad      GameMode = "zombie-squad"
+	GameModeZombieSquadFPP   GameMode = "zombie-squad-fpp"
+	GameModeLabTPP           GameMode = "lab-tpp"
+	GameModeLabFPP           GameMode = "lab-fpp"
+	GameModeTDM              GameMode = "tdm"
+)
+
+// KnownGameModes represents supported game modes
+var KnownGameModes = []GameMode{
+	GameModeSolo,
+	GameModeSoloFPP,
+	GameModeDuo,
+	GameModeDuoFPP,
+	GameModeSquad,
+	GameModeSquadFPP,
+	GameModeNormalSolo,
+	GameModeNormalSoloFPP,
+	GameModeNormalDuo,
+	GameModeNormalDuoFPP,
+	GameModeNormalSquad,
+	GameModeNormalSquadFPP,
+	GameModeEsportsSolo,
+	GameModeEsportsSoloFPP,
+	GameModeEsportsDuo,
+	GameModeEsportsDuoFPP,
+	GameModeEsportsSquad,
+	GameModeEsportsSquadFPP,
+	GameModeConquestSolo,
+	GameModeConquestSoloFPP,
+	GameModeConquestDuo,
+	GameModeConquestDuoFPP,
+	GameModeConquestSquad,
+	GameModeConquestSquadFPP,
+	GameModeWarSolo,
+	GameModeWarSoloFPP,
+	GameModeWarDuo,
+	GameModeWarDuoFPP,
+	GameModeWarSquad,
+	GameModeWarSquadFPP,
+	GameModeZombieSolo,
+	GameModeZombieSoloFPP,
+	GameModeZombieDuo,
+	GameModeZombieDuoFPP,
+	GameModeZombieSquad,
+	GameModeZombieSquadFPP,
+	GameModeLabTPP,
+	GameModeLabFPP,
+	GameModeTDM,
+}
+
+// StandardGameModes represents the game modes of regular matchmaking, the
+// only ones tracked by season and lifetime stats
+var StandardGameModes = []GameMode{
+	GameModeSolo,
+	GameModeSoloFPP,
+	GameModeDuo,
+	GameModeDuoFPP,
+	GameModeSquad,
+	GameModeSquadFPP,
+}
+
+// ParseGameMode converts a string to a game mode, failing if the game mode is
+// unknown
+func ParseGameMode(value string) (GameMode, error) {
+	gameMode := GameMode(value)
+	if !gameMode.Valid() {
+		return "", fmt.Errorf("unknown game mode %q", value)
+	}
+	return gameMode, nil
+}
+
+// Valid checks whether the game mode is known
+func (g GameMode) Valid() bool {
+	for _, gameMode := range KnownGameModes {
+		if g == gameMode {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStandard checks whether the game mode is one of StandardGameModes
+func (g GameMode) IsStandard() bool {
+	for _, gameMode := range StandardGameModes {
+		if g == gameMode {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFPP checks whether the game mode is played in first person only
+func (g GameMode) IsFPP() bool {
+	return strings.HasSuffix(string(g), "-fpp")
+}
@@ -0,0 +1,83 @@
+package pubg
+
+import "fmt"
+
+// Shard represents a platform shard of the API
+type Shard string
+
+// Platform shards
+const (
+	ShardSteam      Shard = "steam"
+	ShardKakao      Shard = "kakao"
+	ShardStadia     Shard = "stadia"
+	ShardPSN        Shard = "psn"
+	ShardXbox       Shard = "xbox"
+	ShardConsole    Shard = "console"
+	ShardTournament Shard = "tournament"
+)
+
+// Legacy regional shards, still used by old matches and lifetime stats
+const (
+	ShardPCAS         Shard = "pc-as"
+	ShardPCEU         Shard = "pc-eu"
+	ShardPCJP         Shard = "pc-jp"
+	ShardPCKakao      Shard = "pc-kakao"
+	ShardPCKRJP       Shard = "pc-krjp"
+	ShardPCNA         Shard = "pc-na"
+	ShardPCOC         Shard = "pc-oc"
+	ShardPCRU         Shard = "pc-ru"
+	ShardPCSA         Shard = "pc-sa"
+	ShardPCSEA        Shard = "pc-sea"
+	ShardPCTournament Shard = "pc-tournament"
+	ShardXboxAS       Shard = "xbox-as"
+	ShardXboxEU       Shard = "xbox-eu"
+	ShardXboxNA       Shard = "xbox-na"
+	ShardXboxOC       Shard = "xbox-oc"
+	ShardXboxSA       Shard = "xbox-sa"
+)
+
+// KnownShards represents supported shards
+var KnownShards = []Shard{
+	ShardSteam,
+	ShardKakao,
+	ShardStadia,
+	ShardPSN,
+	ShardXbox,
+	ShardConsole,
+	ShardTournament,
+	ShardPCAS,
+	ShardPCEU,
+	ShardPCJP,
+	ShardPCKakao,
+	ShardPCKRJP,
+	ShardPCNA,
+	ShardPCOC,
+	ShardPCRU,
+	ShardPCSA,
+	ShardPCSEA,
+	ShardPCTournament,
+	ShardXboxAS,
+	ShardXboxEU,
+	ShardXboxNA,
+	ShardXboxOC,
+	ShardXboxSA,
+}
+
+// ParseShard converts a string to a shard, failing if the shard is unknown
+func ParseShard(value string) (Shard, error) {
+	shard := Shard(value)
+	if !shard.Valid() {
+		return "", fmt.Errorf("unknown shard %q", value)
+	}
+	return shard, nil
+}
+
+// Valid checks whether the shard is known
+func (s Shard) Valid() bool {
+	for _, shard := range KnownShards {
+		if s == shard {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package sample
+
+import (
+	"io"
+	"time"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// Sample structure represents a set of random matches of a shard
+type Sample struct {
+	ID        string    `jsonapi:"primary,sample"`
+	CreatedAt time.Time `jsonapi:"attr,createdAt,iso8601"`
+	ShardID   string    `jsonapi:"attr,shardId"`
+	TitleID   string    `jsonapi:"attr,titleId"`
+	Matches   []*Match  `jsonapi:"relation,matches"`
+}
+
+// Match structure represents a reference to a sampled match
+type Match struct {
+	ID string `jsonapi:"primary,match"`
+}
+
+// MatchIDs returns the IDs of the sampled matches
+func (s *Sample) MatchIDs() []string {
+	ids := make([]string, len(s.Matches))
+	for idx, match := range s.Matches {
+		ids[idx] = match.ID
+	}
+	return ids
+}
+
+// ParseSample parses a json response containing a sample of matches
+func ParseSample(in io.Reader) (*Sample, error) {
+	sample := new(Sample)
+	if err := jsonapi.UnmarshalPayload(in, sample); err != nil {
+		return nil, err
+	}
+	return sample, nil
+}
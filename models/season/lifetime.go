@@ -0,0 +1,58 @@
+package season
+
+import (
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// LifetimeID is the identifier of the pseudo season covering the whole career
+// of a player. Lifetime stats are returned as a PlayerSeason.
+const LifetimeID = "lifetime"
+
+// Total sums the stats of every game mode into career totals. Only additive
+// stats, and the longest kill and survival time, are computed.
+func (g *GameModeStats) Total() Stats {
+	var total Stats
+	for _, stats := range []*Stats{&g.Solo, &g.SoloFPP, &g.Duo, &g.DuoFPP, &g.Squad, &g.SquadFPP} {
+		total.Assists += stats.Assists
+		total.DBNOs += stats.DBNOs
+		total.DamageDealt += stats.DamageDealt
+		total.HeadshotKills += stats.HeadshotKills
+		total.Kills += stats.Kills
+		total.Losses += stats.Losses
+		total.Revives += stats.Revives
+		total.RoundsPlayed += stats.RoundsPlayed
+		total.TimeSurvived += stats.TimeSurvived
+		total.Top10s += stats.Top10s
+		total.Wins += stats.Wins
+		if stats.LongestKill > total.LongestKill {
+			total.LongestKill = stats.LongestKill
+		}
+		if stats.LongestTimeSurvived > total.LongestTimeSurvived {
+			total.LongestTimeSurvived = stats.LongestTimeSurvived
+		}
+	}
+	return total
+}
+
+// ParsePlayerSeasons parses a json response containing the stats of several
+// players, as returned by the batched lifetime stats endpoint
+func ParsePlayerSeasons(in io.Reader) ([]*PlayerSeason, error) {
+	result, err := jsonapi.UnmarshalManyPayload(in, reflect.TypeOf(new(PlayerSeason)))
+	if err != nil {
+		return nil, err
+	}
+
+	playerSeasons := make([]*PlayerSeason, len(result))
+	for idx, elt := range result {
+		playerSeason, ok := elt.(*PlayerSeason)
+		if !ok {
+			return nil, errors.New("Failed to convert player seasons")
+		}
+		playerSeasons[idx] = playerSeason
+	}
+	return playerSeasons, nil
+}
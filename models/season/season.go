@@ -0,0 +1,45 @@
+package season
+
+import (
+	"errors"
+	"io"
+	"reflect"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// Season structure represents a PUBG season
+type Season struct {
+	ID              string `jsonapi:"primary,season"`
+	IsCurrentSeason bool   `jsonapi:"attr,isCurrentSeason"`
+	IsOffseason     bool   `jsonapi:"attr,isOffseason"`
+}
+
+// Current returns the current season from a list of seasons, or nil if none
+// of them is current
+func Current(seasons []*Season) *Season {
+	for _, season := range seasons {
+		if season.IsCurrentSeason {
+			return season
+		}
+	}
+	return nil
+}
+
+// ParseSeasons parses a json response containing seasons information
+func ParseSeasons(in io.Reader) ([]*Season, error) {
+	result, err := jsonapi.UnmarshalManyPayload(in, reflect.TypeOf(new(Season)))
+	if err != nil {
+		return nil, err
+	}
+
+	seasons := make([]*Season, len(result))
+	for idx, elt := range result {
+		season, ok := elt.(*Season)
+		if !ok {
+			return nil, errors.New("Failed to convert seasons")
+		}
+		seasons[idx] = season
+	}
+	return seasons, nil
+}
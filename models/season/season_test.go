@@ -0,0 +1,93 @@
+package season
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/driquet/gopubg/models/pubg"
+)
+
+func TestParsePlayerSeason(t *testing.T) {
+	input := `{
+		"data": {
+			"type": "playerSeason",
+			"attributes": {
+				"gameModeStats": {
+					"solo": {"kills": 3, "wins": 1, "roundsPlayed": 10, "longestKill": 120.5},
+					"squad-fpp": {"kills": 12, "wins": 2, "roundsPlayed": 30, "longestKill": 250, "rankPointsTitle": "3-1"}
+				}
+			},
+			"relationships": {
+				"player": {"data": {"type": "player", "id": "account.a"}},
+				"season": {"data": {"type": "season", "id": "division.bro.official.pc-2018-18"}}
+			}
+		}
+	}`
+
+	playerSeason, err := ParsePlayerSeason(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if playerSeason.Player == nil || playerSeason.Player.ID != "account.a" {
+		t.Errorf("unexpected player %+v", playerSeason.Player)
+	}
+	if playerSeason.Season == nil || playerSeason.Season.ID != "division.bro.official.pc-2018-18" {
+		t.Errorf("unexpected season %+v", playerSeason.Season)
+	}
+
+	stats := playerSeason.GameModeStats
+	if stats.Solo.Kills != 3 || stats.SquadFPP.Kills != 12 || stats.SquadFPP.RankPointsTitle != "3-1" {
+		t.Errorf("unexpected game mode stats %+v", stats)
+	}
+
+	if stats.ByGameMode(pubg.GameModeSquadFPP) != &stats.SquadFPP {
+		t.Error("ByGameMode did not return the squad-fpp stats")
+	}
+	if stats.ByGameMode(pubg.GameModeNormalSquadFPP) != nil {
+		t.Error("expected no stats for a non-standard game mode")
+	}
+
+	total := stats.Total()
+	if total.Kills != 15 || total.Wins != 3 || total.RoundsPlayed != 40 || total.LongestKill != 250 {
+		t.Errorf("unexpected total %+v", total)
+	}
+}
+
+func TestParsePlayerSeasons(t *testing.T) {
+	input := `{
+		"data": [
+			{
+				"type": "playerSeason",
+				"attributes": {"gameModeStats": {"duo": {"kills": 4}}},
+				"relationships": {"player": {"data": {"type": "player", "id": "account.a"}}}
+			},
+			{
+				"type": "playerSeason",
+				"attributes": {"gameModeStats": {"duo": {"kills": 9}}},
+				"relationships": {"player": {"data": {"type": "player", "id": "account.b"}}}
+			}
+		]
+	}`
+
+	playerSeasons, err := ParsePlayerSeasons(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(playerSeasons) != 2 {
+		t.Fatalf("expected 2 player seasons, got %d", len(playerSeasons))
+	}
+	for idx, expected := range []struct {
+		accountID string
+		kills     int
+	}{{"account.a", 4}, {"account.b", 9}} {
+		playerSeason := playerSeasons[idx]
+		if playerSeason.Player == nil || playerSeason.Player.ID != expected.accountID {
+			t.Errorf("%d: unexpected player %+v", idx, playerSeason.Player)
+		}
+		if playerSeason.GameModeStats.Duo.Kills != expected.kills {
+			t.Errorf("%d: expected %d kills, got %d", idx, expected.kills, playerSeason.GameModeStats.Duo.Kills)
+		}
+	}
+}
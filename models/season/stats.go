@@ -0,0 +1,101 @@
+package season
+
+import (
+	"io"
+
+	"github.com/driquet/gopubg/models/pubg"
+	"github.com/slemgrim/jsonapi"
+)
+
+// PlayerSeason structure represents the stats of a player during a season
+type PlayerSeason struct {
+	ID            string        `jsonapi:"primary,playerSeason"`
+	GameModeStats GameModeStats `jsonapi:"attr,gameModeStats"`
+	Player        *Player       `jsonapi:"relation,player"`
+	Season        *Season       `jsonapi:"relation,season"`
+}
+
+// Player structure represents the player the stats belong to
+type Player struct {
+	ID string `jsonapi:"primary,player"`
+}
+
+// GameModeStats structure holds the stats of each game mode
+type GameModeStats struct {
+	Solo     Stats `json:"solo"`
+	SoloFPP  Stats `json:"solo-fpp"`
+	Duo      Stats `json:"duo"`
+	DuoFPP   Stats `json:"duo-fpp"`
+	Squad    Stats `json:"squad"`
+	SquadFPP Stats `json:"squad-fpp"`
+}
+
+// ByGameMode returns the stats of a game mode, or nil if the game mode is not
+// one of pubg.StandardGameModes
+func (g *GameModeStats) ByGameMode(gameMode pubg.GameMode) *Stats {
+	switch gameMode {
+	case pubg.GameModeSolo:
+		return &g.Solo
+	case pubg.GameModeSoloFPP:
+		return &g.SoloFPP
+	case pubg.GameModeDuo:
+		return &g.Duo
+	case pubg.GameModeDuoFPP:
+		return &g.DuoFPP
+	case pubg.GameModeSquad:
+		return &g.Squad
+	case pubg.GameModeSquadFPP:
+		return &g.SquadFPP
+	}
+	return nil
+}
+
+// Stats structure represents the stats of a player for a game mode
+type Stats struct {
+	Assists             int     `json:"assists"`
+	BestRankPoint       float64 `json:"bestRankPoint"`
+	Boosts              int     `json:"boosts"`
+	DBNOs               int     `json:"dBNOs"`
+	DailyKills          int     `json:"dailyKills"`
+	DailyWins           int     `json:"dailyWins"`
+	DamageDealt         float64 `json:"damageDealt"`
+	Days                int     `json:"days"`
+	HeadshotKills       int     `json:"headshotKills"`
+	Heals               int     `json:"heals"`
+	KillPoints          float64 `json:"killPoints"`
+	Kills               int     `json:"kills"`
+	LongestKill         float64 `json:"longestKill"`
+	LongestTimeSurvived float64 `json:"longestTimeSurvived"`
+	Losses              int     `json:"losses"`
+	MaxKillStreaks      int     `json:"maxKillStreaks"`
+	MostSurvivalTime    float64 `json:"mostSurvivalTime"`
+	RankPoints          float64 `json:"rankPoints"`
+	RankPointsTitle     string  `json:"rankPointsTitle"`
+	Revives             int     `json:"revives"`
+	RideDistance        float64 `json:"rideDistance"`
+	RoadKills           int     `json:"roadKills"`
+	RoundMostKills      int     `json:"roundMostKills"`
+	RoundsPlayed        int     `json:"roundsPlayed"`
+	Suicides            int     `json:"suicides"`
+	SwimDistance        float64 `json:"swimDistance"`
+	TeamKills           int     `json:"teamKills"`
+	TimeSurvived        float64 `json:"timeSurvived"`
+	Top10s              int     `json:"top10s"`
+	VehicleDestroys     int     `json:"vehicleDestroys"`
+	WalkDistance        float64 `json:"walkDistance"`
+	WeaponsAcquired     int     `json:"weaponsAcquired"`
+	WeeklyKills         int     `json:"weeklyKills"`
+	WeeklyWins          int     `json:"weeklyWins"`
+	WinPoints           float64 `json:"winPoints"`
+	Wins                int     `json:"wins"`
+}
+
+// ParsePlayerSeason parses a json response containing the season stats of a
+// player
+func ParsePlayerSeason(in io.Reader) (*PlayerSeason, error) {
+	playerSeason := new(PlayerSeason)
+	if err := jsonapi.UnmarshalPayload(in, playerSeason); err != nil {
+		return nil, err
+	}
+	return playerSeason, nil
+}
@@ -0,0 +1,24 @@
+package status
+
+import (
+	"io"
+	"time"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// Status structure represents the status of the PUBG API
+type Status struct {
+	ID         string    `jsonapi:"primary,status" json:"id"`
+	ReleasedAt time.Time `jsonapi:"attr,releasedAt,iso8601" json:"releasedAt"`
+	Version    string    `jsonapi:"attr,version" json:"version"`
+}
+
+// ParseStatus parses a json response containing the API status
+func ParseStatus(in io.Reader) (*Status, error) {
+	status := new(Status)
+	if err := jsonapi.UnmarshalPayload(in, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
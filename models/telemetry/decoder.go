@@ -0,0 +1,127 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Decoder reads the events of a telemetry file one at a time, without loading
+// the whole file in memory:
+//
+//	dec := telemetry.NewDecoder(file)
+//	for dec.Next() {
+//		evt := dec.Event()
+//	}
+//	if err := dec.Err(); err != nil {
+//		...
+//	}
+type Decoder struct {
+	// Lenient makes the decoder accept unknown event types and values, they
+	// are listed in the report instead of stopping the decoding
+	Lenient bool
+
+	dec     *json.Decoder
+	report  *ParseReport
+	started bool
+	done    bool
+	raw     json.RawMessage
+	current *TelemetryEvent
+	err     error
+}
+
+// NewDecoder returns a decoder reading the telemetry events from in
+func NewDecoder(in io.Reader) *Decoder {
+	return &Decoder{
+		dec:    json.NewDecoder(in),
+		report: newParseReport(),
+	}
+}
+
+// Next decodes the next event, it returns false when there are no more events
+// or an error occurred
+func (d *Decoder) Next() bool {
+	d.raw, d.current = nil, nil
+	if d.err != nil || d.done {
+		return false
+	}
+
+	if !d.started {
+		d.started = true
+		if err := d.expectDelim('['); err != nil {
+			d.err = err
+			return false
+		}
+	}
+
+	if !d.dec.More() {
+		if err := d.expectDelim(']'); err != nil {
+			d.err = err
+		}
+		d.done = true
+		return false
+	}
+
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		d.err = err
+		return false
+	}
+
+	var te TelemetryEvent
+	if err := json.Unmarshal(raw, &te); err != nil {
+		d.err = err
+		return false
+	}
+
+	d.report.add(&te)
+	if !d.Lenient {
+		if err := d.report.err(); err != nil {
+			d.err = err
+			return false
+		}
+	}
+
+	d.raw, d.current = raw, &te
+	return true
+}
+
+// expectDelim reads the next token, which must be the given delimiter
+func (d *Decoder) expectDelim(delim json.Delim) error {
+	token, err := d.dec.Token()
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("telemetry: expected %s, got %v", delim, token)
+	}
+	return nil
+}
+
+// Event returns the event decoded by the last call to Next
+func (d *Decoder) Event() *TelemetryEvent {
+	return d.current
+}
+
+// TypedEvent decodes the event read by the last call to Next into the struct
+// matching its type, see ParseEvent
+func (d *Decoder) TypedEvent() (Event, error) {
+	if d.raw == nil {
+		return nil, errors.New("telemetry: no event decoded")
+	}
+	return ParseEvent(d.raw)
+}
+
+// Err returns the error that stopped the decoding, if any
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// Report returns the unknown values met so far
+func (d *Decoder) Report() *ParseReport {
+	return d.report
+}
@@ -0,0 +1,82 @@
+package telemetry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		events int
+		err    bool
+	}{
+		{"empty array", `[]`, 0, false},
+		{"whitespace", " \n[ ] \n", 0, false},
+		{"single event", `[{"_T":"LogMatchDefinition","matchId":"match"}]`, 1, false},
+		{"several events", `[{"_T":"LogMatchDefinition"},{"_T":"LogMatchStart"},{"_T":"LogMatchEnd"}]`, 3, false},
+		{"empty input", ``, 0, true},
+		{"not an array", `{"_T":"LogMatchDefinition"}`, 0, true},
+		{"truncated array", `[{"_T":"LogMatchDefinition"}`, 1, true},
+		{"truncated event", `[{"_T":"LogMatchDefinition"},{"_T":"LogMa`, 1, true},
+		{"invalid event", `[{"_T":"LogMatchDefinition"},42]`, 1, true},
+	}
+
+	for _, test := range tests {
+		dec := NewDecoder(strings.NewReader(test.input))
+
+		events := 0
+		for dec.Next() {
+			if dec.Event() == nil {
+				t.Errorf("%s: Next returned true without event", test.name)
+			}
+			events++
+		}
+
+		if events != test.events {
+			t.Errorf("%s: expected %d events, got %d", test.name, test.events, events)
+		}
+		if (dec.Err() != nil) != test.err {
+			t.Errorf("%s: unexpected error %v", test.name, dec.Err())
+		}
+
+		// Calling Next after the end changes neither the result nor the error
+		err := dec.Err()
+		if dec.Next() {
+			t.Errorf("%s: Next returned true after the end", test.name)
+		}
+		if dec.Event() != nil {
+			t.Errorf("%s: Event returned an event after the end", test.name)
+		}
+		if dec.Err() != err {
+			t.Errorf("%s: error changed from %v to %v after the end", test.name, err, dec.Err())
+		}
+	}
+}
+
+func TestDecoderStrict(t *testing.T) {
+	input := `[{"_T":"LogMatchDefinition"},{"_T":"LogFutureEvent"},{"_T":"LogMatchEnd"}]`
+
+	dec := NewDecoder(strings.NewReader(input))
+	events := 0
+	for dec.Next() {
+		events++
+	}
+	if events != 1 || dec.Err() == nil {
+		t.Errorf("strict decoder read %d events with error %v", events, dec.Err())
+	}
+
+	dec = NewDecoder(strings.NewReader(input))
+	dec.Lenient = true
+	events = 0
+	for dec.Next() {
+		events++
+	}
+	if events != 3 || dec.Err() != nil {
+		t.Errorf("lenient decoder read %d events with error %v", events, dec.Err())
+	}
+	if dec.Report().Unknown[UnknownValue{"TelemetryEventType", "LogFutureEvent"}] != 1 {
+		t.Errorf("unknown event type not reported: %v", dec.Report().Unknown)
+	}
+}
@@ -0,0 +1,564 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is implemented by the typed telemetry events, such as LogPlayerKill.
+// Use a type switch to access the fields of a given type of event:
+//
+//	switch e := evt.(type) {
+//	case *telemetry.LogPlayerKill:
+//		fmt.Println(e.Killer.Name, "killed", e.Victim.Name)
+//	}
+type Event interface {
+	Type() TelemetryEventType
+	Timestamp() time.Time
+	Version() int
+}
+
+// EventHeader holds the fields common to every telemetry event
+type EventHeader struct {
+	EventVersion   int                `json:"_V"`
+	EventTimestamp time.Time          `json:"_D"`
+	EventType      TelemetryEventType `json:"_T"`
+	U              bool               `json:"_U"`
+}
+
+// Type returns the type of the event
+func (h *EventHeader) Type() TelemetryEventType {
+	return h.EventType
+}
+
+// Timestamp returns the time the event occurred
+func (h *EventHeader) Timestamp() time.Time {
+	return h.EventTimestamp
+}
+
+// Version returns the version of the event
+func (h *EventHeader) Version() int {
+	return h.EventVersion
+}
+
+// LogPlayerLogin is the event of a player logging in
+type LogPlayerLogin struct {
+	EventHeader
+	Result       bool   `json:"result"`
+	ErrorMessage string `json:"errorMessage"`
+	AccountID    string `json:"accountId"`
+}
+
+// LogPlayerLogout is the event of a player logging out
+type LogPlayerLogout struct {
+	EventHeader
+	AccountID string `json:"accountId"`
+}
+
+// LogPlayerCreate is the event of the creation of a player character
+type LogPlayerCreate struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+}
+
+// LogPlayerPosition is the periodic event of the position of a player
+type LogPlayerPosition struct {
+	EventHeader
+	Character       *TelemetryCharacter `json:"character"`
+	ElapsedTime     float64             `json:"elapsedTime"`
+	NumAlivePlayers int                 `json:"numAlivePlayers"`
+}
+
+// LogPlayerAttack is the event of a player attacking
+type LogPlayerAttack struct {
+	EventHeader
+	AttackID   int                 `json:"attackId"`
+	Attacker   *TelemetryCharacter `json:"attacker"`
+	AttackType TelemetryAttackType `json:"attackType"`
+	Weapon     *TelemetryItem      `json:"weapon"`
+	Vehicle    *TelemetryVehicle   `json:"vehicle"`
+}
+
+// LogPlayerTakeDamage is the event of a player taking damage
+type LogPlayerTakeDamage struct {
+	EventHeader
+	AttackID           int                   `json:"attackId"`
+	Attacker           *TelemetryCharacter   `json:"attacker"`
+	Victim             *TelemetryCharacter   `json:"victim"`
+	DamageTypeCategory TelemetryDamageType   `json:"damageTypeCategory"`
+	DamageReason       TelemetryDamageReason `json:"damageReason"`
+	Damage             float64               `json:"damage"`
+	DamageCauserName   string                `json:"damageCauserName"`
+}
+
+// LogPlayerKill is the event of a player being killed
+type LogPlayerKill struct {
+	EventHeader
+	AttackID           int                  `json:"attackId"`
+	Killer             *TelemetryCharacter  `json:"killer"`
+	Victim             *TelemetryCharacter  `json:"victim"`
+	DamageTypeCategory TelemetryDamageType  `json:"damageTypeCategory"`
+	DamageCauserName   string               `json:"damageCauserName"`
+	Distance           float64              `json:"distance"`
+	DBNOID             int                  `json:"dBNOId"`
+	VictimGameResult   *TelemetryGameResult `json:"victimGameResult"`
+}
+
+// LogItemPickup is the event of a player picking up an item
+type LogItemPickup struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogItemDrop is the event of a player dropping an item
+type LogItemDrop struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogItemEquip is the event of a player equipping an item
+type LogItemEquip struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogItemUnequip is the event of a player unequipping an item
+type LogItemUnequip struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogItemAttach is the event of a player attaching an item to another one
+type LogItemAttach struct {
+	EventHeader
+	Character  *TelemetryCharacter `json:"character"`
+	ParentItem *TelemetryItem      `json:"parentItem"`
+	ChildItem  *TelemetryItem      `json:"childItem"`
+}
+
+// LogItemDetach is the event of a player detaching an item from another one
+type LogItemDetach struct {
+	EventHeader
+	Character  *TelemetryCharacter `json:"character"`
+	ParentItem *TelemetryItem      `json:"parentItem"`
+	ChildItem  *TelemetryItem      `json:"childItem"`
+}
+
+// LogItemUse is the event of a player using an item
+type LogItemUse struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogVehicleRide is the event of a player getting in a vehicle
+type LogVehicleRide struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Vehicle   *TelemetryVehicle   `json:"vehicle"`
+}
+
+// LogVehicleLeave is the event of a player getting out of a vehicle
+type LogVehicleLeave struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Vehicle   *TelemetryVehicle   `json:"vehicle"`
+}
+
+// LogVehicleDestroy is the event of a vehicle being destroyed
+type LogVehicleDestroy struct {
+	EventHeader
+	AttackID           int                 `json:"attackId"`
+	Attacker           *TelemetryCharacter `json:"attacker"`
+	Vehicle            *TelemetryVehicle   `json:"vehicle"`
+	DamageTypeCategory TelemetryDamageType `json:"damageTypeCategory"`
+	DamageCauserName   string              `json:"damageCauserName"`
+	Distance           float64             `json:"distance"`
+}
+
+// LogMatchStart is the event of the start of the match
+type LogMatchStart struct {
+	EventHeader
+	Characters []*TelemetryCharacterWrapper `json:"characters"`
+	MapName    string                       `json:"mapName"`
+}
+
+// LogMatchEnd is the event of the end of the match
+type LogMatchEnd struct {
+	EventHeader
+	Characters []*TelemetryCharacterWrapper `json:"characters"`
+}
+
+// LogMatchDefinition is the first event of a telemetry file, it identifies
+// the match
+type LogMatchDefinition struct {
+	EventHeader
+	MatchID     string `json:"matchId"`
+	PingQuality string `json:"pingQuality"`
+}
+
+// LogGameStatePeriodic is the periodic event of the state of the game
+type LogGameStatePeriodic struct {
+	EventHeader
+	GameState *TelemetryGameState `json:"gameState"`
+}
+
+// LogCarePackageSpawn is the event of a care package being dropped
+type LogCarePackageSpawn struct {
+	EventHeader
+	ItemPackage *TelemetryItemPackage `json:"itemPackage"`
+}
+
+// LogCarePackageLand is the event of a care package landing
+type LogCarePackageLand struct {
+	EventHeader
+	ItemPackage *TelemetryItemPackage `json:"itemPackage"`
+}
+
+// LogPlayerMakeGroggy is the event of a player being knocked down
+type LogPlayerMakeGroggy struct {
+	EventHeader
+	AttackID                   int                   `json:"attackId"`
+	Attacker                   *TelemetryCharacter   `json:"attacker"`
+	Victim                     *TelemetryCharacter   `json:"victim"`
+	DamageReason               TelemetryDamageReason `json:"damageReason"`
+	DamageTypeCategory         TelemetryDamageType   `json:"damageTypeCategory"`
+	DamageCauserName           string                `json:"damageCauserName"`
+	DamageCauserAdditionalInfo []string              `json:"damageCauserAdditionalInfo"`
+	VictimWeapon               string                `json:"victimWeapon"`
+	VictimWeaponAdditionalInfo []string              `json:"victimWeaponAdditionalInfo"`
+	Distance                   float64               `json:"distance"`
+	IsAttackerInVehicle        bool                  `json:"isAttackerInVehicle"`
+	DBNOID                     int                   `json:"dBNOId"`
+	IsThroughPenetrableWall    bool                  `json:"isThroughPenetrableWall"`
+}
+
+// LogPlayerRevive is the event of a knocked down player being revived
+type LogPlayerRevive struct {
+	EventHeader
+	Reviver *TelemetryCharacter `json:"reviver"`
+	Victim  *TelemetryCharacter `json:"victim"`
+	DBNOID  int                 `json:"dBNOId"`
+}
+
+// LogArmorDestroy is the event of the armor of a player being destroyed
+type LogArmorDestroy struct {
+	EventHeader
+	AttackID           int                   `json:"attackId"`
+	Attacker           *TelemetryCharacter   `json:"attacker"`
+	Victim             *TelemetryCharacter   `json:"victim"`
+	DamageTypeCategory TelemetryDamageType   `json:"damageTypeCategory"`
+	DamageReason       TelemetryDamageReason `json:"damageReason"`
+	DamageCauserName   string                `json:"damageCauserName"`
+	Item               *TelemetryItem        `json:"item"`
+	Distance           float64               `json:"distance"`
+}
+
+// LogParachuteLanding is the event of a player landing with a parachute
+type LogParachuteLanding struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Distance  float64             `json:"distance"`
+}
+
+// LogSwimStart is the event of a player starting to swim
+type LogSwimStart struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+}
+
+// LogSwimEnd is the event of a player getting out of the water
+type LogSwimEnd struct {
+	EventHeader
+	Character           *TelemetryCharacter `json:"character"`
+	SwimDistance        float64             `json:"swimDistance"`
+	MaxSwimDepthOfWater float64             `json:"maxSwimDepthOfWater"`
+}
+
+// LogHeal is the event of a player being healed
+type LogHeal struct {
+	EventHeader
+	Character  *TelemetryCharacter `json:"character"`
+	Item       *TelemetryItem      `json:"item"`
+	HealAmount float64             `json:"healAmount"`
+}
+
+// LogPhaseChange is the event of the start of a new phase of the blue zone
+type LogPhaseChange struct {
+	EventHeader
+	Phase       int     `json:"phase"`
+	ElapsedTime float64 `json:"elapsedTime"`
+}
+
+// LogObjectDestroy is the event of a player destroying an object, such as a
+// door
+type LogObjectDestroy struct {
+	EventHeader
+	Character      *TelemetryCharacter `json:"character"`
+	ObjectType     string              `json:"objectType"`
+	ObjectLocation *TelemetryLocation  `json:"objectLocation"`
+}
+
+// LogWheelDestroy is the event of the wheel of a vehicle being destroyed
+type LogWheelDestroy struct {
+	EventHeader
+	AttackID           int                 `json:"attackId"`
+	Attacker           *TelemetryCharacter `json:"attacker"`
+	Vehicle            *TelemetryVehicle   `json:"vehicle"`
+	DamageTypeCategory TelemetryDamageType `json:"damageTypeCategory"`
+	DamageCauserName   string              `json:"damageCauserName"`
+}
+
+// LogVaultStart is the event of a player starting to vault
+type LogVaultStart struct {
+	EventHeader
+	Character   *TelemetryCharacter `json:"character"`
+	IsLedgeGrab bool                `json:"isLedgeGrab"`
+}
+
+// LogRedZoneEnded is the event of the end of a red zone
+type LogRedZoneEnded struct {
+	EventHeader
+	Drivers []*TelemetryCharacter `json:"drivers"`
+}
+
+// LogItemPickupFromCarepackage is the event of a player picking up an item from
+// a care package
+type LogItemPickupFromCarepackage struct {
+	EventHeader
+	Character           *TelemetryCharacter `json:"character"`
+	Item                *TelemetryItem      `json:"item"`
+	CarePackageUniqueID int64               `json:"carePackageUniqueId"`
+}
+
+// LogItemPickupFromLootBox is the event of a player picking up an item from the
+// loot box of a dead player
+type LogItemPickupFromLootBox struct {
+	EventHeader
+	Character        *TelemetryCharacter `json:"character"`
+	Item             *TelemetryItem      `json:"item"`
+	OwnerTeamID      int                 `json:"ownerTeamId"`
+	CreatorAccountID string              `json:"creatorAccountId"`
+}
+
+// LogPlayerUseThrowable is the event of a player throwing a throwable, such as
+// a grenade
+type LogPlayerUseThrowable struct {
+	EventHeader
+	AttackID             int                 `json:"attackId"`
+	FireWeaponStackCount int                 `json:"fireWeaponStackCount"`
+	Attacker             *TelemetryCharacter `json:"attacker"`
+	AttackType           TelemetryAttackType `json:"attackType"`
+	Weapon               *TelemetryItem      `json:"weapon"`
+}
+
+// LogWeaponFireCount is the periodic event of the number of shots fired by a
+// player with a weapon
+type LogWeaponFireCount struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	WeaponID  string              `json:"weaponId"`
+	FireCount int                 `json:"fireCount"`
+}
+
+// LogCharacterCarry is the event of a player carrying, or stopping to carry, a
+// knocked down player
+type LogCharacterCarry struct {
+	EventHeader
+	Character  *TelemetryCharacter `json:"character"`
+	CarryState string              `json:"carryState"`
+}
+
+// LogPlayerKillV2 is the event of a player being killed, it details the
+// players who knocked down, finished and killed the victim
+type LogPlayerKillV2 struct {
+	EventHeader
+	AttackID                   int                  `json:"attackId"`
+	DBNOID                     int                  `json:"dBNOId"`
+	VictimGameResult           *TelemetryGameResult `json:"victimGameResult"`
+	Victim                     *TelemetryCharacter  `json:"victim"`
+	VictimWeapon               string               `json:"victimWeapon"`
+	VictimWeaponAdditionalInfo []string             `json:"victimWeaponAdditionalInfo"`
+	DBNOMaker                  *TelemetryCharacter  `json:"dBNOMaker"`
+	DBNODamageInfo             *TelemetryDamageInfo `json:"dBNODamageInfo"`
+	Finisher                   *TelemetryCharacter  `json:"finisher"`
+	FinishDamageInfo           *TelemetryDamageInfo `json:"finishDamageInfo"`
+	Killer                     *TelemetryCharacter  `json:"killer"`
+	KillerDamageInfo           *TelemetryDamageInfo `json:"killerDamageInfo"`
+	AssistsAccountID           []string             `json:"assists_AccountId"`
+	TeamKillersAccountID       []string             `json:"teamKillers_AccountId"`
+	IsSuicide                  bool                 `json:"isSuicide"`
+}
+
+// LogObjectInteraction is the event of a player interacting with an object of
+// the map, such as a door or a zipline
+type LogObjectInteraction struct {
+	EventHeader
+	Character        *TelemetryCharacter `json:"character"`
+	ObjectType       string              `json:"objectType"`
+	ObjectTypeStatus string              `json:"objectTypeStatus"`
+	ObjectTypeCount  int                 `json:"objectTypeCount"`
+}
+
+// LogVehicleDamage is the event of a vehicle taking damage
+type LogVehicleDamage struct {
+	EventHeader
+	AttackID           int                 `json:"attackId"`
+	Attacker           *TelemetryCharacter `json:"attacker"`
+	Vehicle            *TelemetryVehicle   `json:"vehicle"`
+	DamageTypeCategory TelemetryDamageType `json:"damageTypeCategory"`
+	DamageCauserName   string              `json:"damageCauserName"`
+	Damage             float64             `json:"damage"`
+	Distance           float64             `json:"distance"`
+}
+
+// LogBlackZoneEnded is the event of the end of a black zone
+type LogBlackZoneEnded struct {
+	EventHeader
+	Survivors []*TelemetryCharacter `json:"survivors"`
+}
+
+// LogItemPutToVehicleTrunk is the event of a player putting an item in the
+// trunk of a vehicle
+type LogItemPutToVehicleTrunk struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Vehicle   *TelemetryVehicle   `json:"vehicle"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogItemPickupFromVehicleTrunk is the event of a player picking up an item
+// from the trunk of a vehicle
+type LogItemPickupFromVehicleTrunk struct {
+	EventHeader
+	Character *TelemetryCharacter `json:"character"`
+	Vehicle   *TelemetryVehicle   `json:"vehicle"`
+	Item      *TelemetryItem      `json:"item"`
+}
+
+// LogPlayerDestroyProp is the event of a player destroying a prop of the map
+type LogPlayerDestroyProp struct {
+	EventHeader
+	Attacker       *TelemetryCharacter `json:"attacker"`
+	ObjectType     string              `json:"objectType"`
+	ObjectLocation *TelemetryLocation  `json:"objectLocation"`
+}
+
+// LogPlayerDestroyBreachableWall is the event of a player breaching a wall
+type LogPlayerDestroyBreachableWall struct {
+	EventHeader
+	Attacker *TelemetryCharacter `json:"attacker"`
+	Weapon   *TelemetryItem      `json:"weapon"`
+}
+
+// LogEmPickupLiftOff is the event of an emergency pickup lifting off with its
+// riders
+type LogEmPickupLiftOff struct {
+	EventHeader
+	Instigator *TelemetryCharacter   `json:"instigator"`
+	Riders     []*TelemetryCharacter `json:"riders"`
+}
+
+// LogPlayerUseFlareGun is the event of a player firing a flare gun
+type LogPlayerUseFlareGun struct {
+	EventHeader
+	AttackID             int                 `json:"attackId"`
+	FireWeaponStackCount int                 `json:"fireWeaponStackCount"`
+	Attacker             *TelemetryCharacter `json:"attacker"`
+	AttackType           TelemetryAttackType `json:"attackType"`
+	Weapon               *TelemetryItem      `json:"weapon"`
+}
+
+// RawEvent is an event of a type that is not supported, its content is kept
+// as is
+type RawEvent struct {
+	EventHeader
+	RawType string
+	Data    json.RawMessage
+}
+
+// newEvent creates an empty event of each supported type
+var newEvent = map[TelemetryEventType]func() Event{
+	PlayerLogin:                 func() Event { return &LogPlayerLogin{} },
+	PlayerLogout:                func() Event { return &LogPlayerLogout{} },
+	PlayerCreate:                func() Event { return &LogPlayerCreate{} },
+	PlayerPosition:              func() Event { return &LogPlayerPosition{} },
+	PlayerAttack:                func() Event { return &LogPlayerAttack{} },
+	PlayerTakeDamage:            func() Event { return &LogPlayerTakeDamage{} },
+	PlayerKill:                  func() Event { return &LogPlayerKill{} },
+	ItemPickup:                  func() Event { return &LogItemPickup{} },
+	ItemDrop:                    func() Event { return &LogItemDrop{} },
+	ItemEquip:                   func() Event { return &LogItemEquip{} },
+	ItemUnequip:                 func() Event { return &LogItemUnequip{} },
+	ItemAttach:                  func() Event { return &LogItemAttach{} },
+	ItemDetach:                  func() Event { return &LogItemDetach{} },
+	ItemUse:                     func() Event { return &LogItemUse{} },
+	VehicleRide:                 func() Event { return &LogVehicleRide{} },
+	VehicleLeave:                func() Event { return &LogVehicleLeave{} },
+	VehicleDestroy:              func() Event { return &LogVehicleDestroy{} },
+	MatchStart:                  func() Event { return &LogMatchStart{} },
+	MatchEnd:                    func() Event { return &LogMatchEnd{} },
+	MatchDefinition:             func() Event { return &LogMatchDefinition{} },
+	GameStatePeriodic:           func() Event { return &LogGameStatePeriodic{} },
+	CarePackageSpawn:            func() Event { return &LogCarePackageSpawn{} },
+	CarePackageLand:             func() Event { return &LogCarePackageLand{} },
+	PlayerMakeGroggy:            func() Event { return &LogPlayerMakeGroggy{} },
+	PlayerRevive:                func() Event { return &LogPlayerRevive{} },
+	ArmorDestroy:                func() Event { return &LogArmorDestroy{} },
+	ParachuteLanding:            func() Event { return &LogParachuteLanding{} },
+	SwimStart:                   func() Event { return &LogSwimStart{} },
+	SwimEnd:                     func() Event { return &LogSwimEnd{} },
+	Heal:                        func() Event { return &LogHeal{} },
+	PhaseChange:                 func() Event { return &LogPhaseChange{} },
+	ObjectDestroy:               func() Event { return &LogObjectDestroy{} },
+	WheelDestroy:                func() Event { return &LogWheelDestroy{} },
+	VaultStart:                  func() Event { return &LogVaultStart{} },
+	RedZoneEnded:                func() Event { return &LogRedZoneEnded{} },
+	ItemPickupFromCarepackage:   func() Event { return &LogItemPickupFromCarepackage{} },
+	ItemPickupFromLootBox:       func() Event { return &LogItemPickupFromLootBox{} },
+	PlayerUseThrowable:          func() Event { return &LogPlayerUseThrowable{} },
+	WeaponFireCount:             func() Event { return &LogWeaponFireCount{} },
+	CharacterCarry:              func() Event { return &LogCharacterCarry{} },
+	PlayerKillV2:                func() Event { return &LogPlayerKillV2{} },
+	ObjectInteraction:           func() Event { return &LogObjectInteraction{} },
+	VehicleDamage:               func() Event { return &LogVehicleDamage{} },
+	BlackZoneEnded:              func() Event { return &LogBlackZoneEnded{} },
+	ItemPutToVehicleTrunk:       func() Event { return &LogItemPutToVehicleTrunk{} },
+	ItemPickupFromVehicleTrunk:  func() Event { return &LogItemPickupFromVehicleTrunk{} },
+	PlayerDestroyProp:           func() Event { return &LogPlayerDestroyProp{} },
+	PlayerDestroyBreachableWall: func() Event { return &LogPlayerDestroyBreachableWall{} },
+	EmPickupLiftOff:             func() Event { return &LogEmPickupLiftOff{} },
+	PlayerUseFlareGun:           func() Event { return &LogPlayerUseFlareGun{} },
+}
+
+// ParseEvent decodes a single telemetry event into the struct matching its
+// type. Events of unknown types are returned as a *RawEvent.
+func ParseEvent(data []byte) (Event, error) {
+	var header EventHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	create, ok := newEvent[header.EventType]
+	if !ok {
+		var raw rawEnums
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+		return &RawEvent{
+			EventHeader: header,
+			RawType:     rawValue(raw.Type),
+			Data:        append(json.RawMessage(nil), data...),
+		}, nil
+	}
+
+	evt := create()
+	if err := json.Unmarshal(data, evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,180 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnknownValue represents a value of a telemetry file that is not supported
+type UnknownValue struct {
+	// Kind is the type the value could not be converted to, such as
+	// TelemetryEventType
+	Kind  string
+	Value string
+}
+
+// ParseReport lists the unknown values met while parsing a telemetry file
+type ParseReport struct {
+	// Unknown counts the occurrences of each unknown value
+	Unknown map[UnknownValue]int
+
+	first *UnknownValue
+}
+
+func newParseReport() *ParseReport {
+	return &ParseReport{
+		Unknown: make(map[UnknownValue]int),
+	}
+}
+
+// Empty checks whether every value of the telemetry file was known
+func (r *ParseReport) Empty() bool {
+	return len(r.Unknown) == 0
+}
+
+func (r *ParseReport) add(te *TelemetryEvent) {
+	for _, value := range te.UnknownValues() {
+		if r.first == nil {
+			first := value
+			r.first = &first
+		}
+		r.Unknown[value]++
+	}
+}
+
+// err returns an error describing the first unknown value met, if any
+func (r *ParseReport) err() error {
+	if r.first == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: Unknown type %q", r.first.Kind, r.first.Value)
+}
+
+// rawEnums captures the raw values of the enums of an event
+type rawEnums struct {
+	Type               json.RawMessage `json:"_T"`
+	AttackType         json.RawMessage `json:"attackType"`
+	DamageTypeCategory json.RawMessage `json:"damageTypeCategory"`
+	DamageReason       json.RawMessage `json:"damageReason"`
+}
+
+// rawValue returns the string held by a raw JSON value, or the raw JSON value
+// itself when it is not a string, such as a number or null
+func rawValue(data json.RawMessage) string {
+	var value *string
+	if err := json.Unmarshal(data, &value); err != nil || value == nil {
+		return string(data)
+	}
+	return *value
+}
+
+// UnmarshalJSON decodes an event, keeping the raw value of its type and of
+// its unknown values
+func (te *TelemetryEvent) UnmarshalJSON(data []byte) error {
+	type event TelemetryEvent
+	if err := json.Unmarshal(data, (*event)(te)); err != nil {
+		return err
+	}
+
+	te.RawType = te.Type.String()
+	if te.Type != UnknownEvent &&
+		te.AttackType != AttackTypeUnknown &&
+		te.DamageTypeCategory != DamageUnknown &&
+		te.DamageReason != DamageReasonUnknown {
+		return nil
+	}
+
+	// Decode the raw values only when some of them are unknown
+	var raw rawEnums
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if te.Type == UnknownEvent {
+		te.RawType = rawValue(raw.Type)
+		te.unknownValues = append(te.unknownValues, UnknownValue{"TelemetryEventType", te.RawType})
+	}
+	if te.AttackType == AttackTypeUnknown {
+		te.unknownValues = append(te.unknownValues, UnknownValue{"TelemetryAttackType", rawValue(raw.AttackType)})
+	}
+	if te.DamageTypeCategory == DamageUnknown {
+		te.unknownValues = append(te.unknownValues, UnknownValue{"TelemetryDamageType", rawValue(raw.DamageTypeCategory)})
+	}
+	if te.DamageReason == DamageReasonUnknown {
+		te.unknownValues = append(te.unknownValues, UnknownValue{"TelemetryDamageReason", rawValue(raw.DamageReason)})
+	}
+	return nil
+}
+
+// UnknownValues returns the unknown values of the event, including the ones
+// of its items and damage information
+func (te *TelemetryEvent) UnknownValues() []UnknownValue {
+	values := te.unknownValues
+
+	items := []*TelemetryItem{te.Weapon, te.Item, te.ParentItem, te.ChildItem}
+	if te.ItemPackage != nil {
+		items = append(items, te.ItemPackage.Items...)
+	}
+	for _, item := range items {
+		if item != nil && item.SubCategory == SubCategoryUnknown {
+			values = append(values, UnknownValue{"TelemetrySubCategory", item.rawSubCategory})
+		}
+	}
+
+	for _, info := range []*TelemetryDamageInfo{te.DBNODamageInfo, te.FinishDamageInfo, te.KillerDamageInfo} {
+		if info == nil {
+			continue
+		}
+		if info.DamageTypeCategory == DamageUnknown {
+			values = append(values, UnknownValue{"TelemetryDamageType", info.rawDamageTypeCategory})
+		}
+		if info.DamageReason == DamageReasonUnknown {
+			values = append(values, UnknownValue{"TelemetryDamageReason", info.rawDamageReason})
+		}
+	}
+
+	return values
+}
+
+// UnmarshalJSON decodes an item, keeping the raw value of its subcategory when
+// it is unknown
+func (i *TelemetryItem) UnmarshalJSON(data []byte) error {
+	type item TelemetryItem
+	if err := json.Unmarshal(data, (*item)(i)); err != nil {
+		return err
+	}
+
+	if i.SubCategory != SubCategoryUnknown {
+		return nil
+	}
+
+	var raw struct {
+		SubCategory json.RawMessage `json:"subCategory"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	i.rawSubCategory = rawValue(raw.SubCategory)
+	return nil
+}
+
+// UnmarshalJSON decodes damage information, keeping the raw value of its type
+// and reason when they are unknown
+func (d *TelemetryDamageInfo) UnmarshalJSON(data []byte) error {
+	type damageInfo TelemetryDamageInfo
+	if err := json.Unmarshal(data, (*damageInfo)(d)); err != nil {
+		return err
+	}
+
+	if d.DamageTypeCategory != DamageUnknown && d.DamageReason != DamageReasonUnknown {
+		return nil
+	}
+
+	var raw rawEnums
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	d.rawDamageTypeCategory = rawValue(raw.DamageTypeCategory)
+	d.rawDamageReason = rawValue(raw.DamageReason)
+	return nil
+}
@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTelemetryUnknownValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		unknown UnknownValue
+	}{
+		{"unknown event type", `{"_T":"LogFutureEvent"}`, UnknownValue{"TelemetryEventType", "LogFutureEvent"}},
+		{"numeric event type", `{"_T":5}`, UnknownValue{"TelemetryEventType", "5"}},
+		{"null event type", `{"_T":null}`, UnknownValue{"TelemetryEventType", "null"}},
+		{"unknown damage type", `{"_T":"LogPlayerTakeDamage","damageTypeCategory":"Damage_Future"}`, UnknownValue{"TelemetryDamageType", "Damage_Future"}},
+		{"numeric damage reason", `{"_T":"LogPlayerTakeDamage","damageReason":3}`, UnknownValue{"TelemetryDamageReason", "3"}},
+		{"unknown attack type", `{"_T":"LogPlayerAttack","attackType":"Laser"}`, UnknownValue{"TelemetryAttackType", "Laser"}},
+		{"unknown sub category", `{"_T":"LogItemPickup","item":{"subCategory":"Gadget"}}`, UnknownValue{"TelemetrySubCategory", "Gadget"}},
+		{"unknown damage info type", `{"_T":"LogPlayerKillV2","killerDamageInfo":{"damageTypeCategory":"Damage_Future"}}`, UnknownValue{"TelemetryDamageType", "Damage_Future"}},
+	}
+
+	for _, test := range tests {
+		input := `[{"_T":"LogMatchDefinition","matchId":"match"},` + test.event + `]`
+
+		if _, err := ParseTelemetry(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: strict parsing accepted an unknown value", test.name)
+		}
+
+		telemetry, report, err := ParseTelemetryLenient(strings.NewReader(input))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(telemetry.Events) != 2 {
+			t.Errorf("%s: expected 2 events, got %d", test.name, len(telemetry.Events))
+		}
+		if len(report.Unknown) != 1 || report.Unknown[test.unknown] != 1 {
+			t.Errorf("%s: expected %v to be reported, got %v", test.name, test.unknown, report.Unknown)
+		}
+	}
+}
+
+func TestParseTelemetryKnownValues(t *testing.T) {
+	input := `[
+		{"_T":"LogMatchDefinition","matchId":"match"},
+		{"_T":"LogPlayerTakeDamage","damageTypeCategory":"Damage_Gun","damageReason":"HeadShot","attacker":{"name":"a"},"victim":{"name":"b"}}
+	]`
+
+	telemetry, report, err := ParseTelemetryLenient(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Empty() {
+		t.Errorf("expected an empty report, got %v", report.Unknown)
+	}
+
+	evt := telemetry.Events[1]
+	if evt.Type != PlayerTakeDamage || evt.DamageTypeCategory != DamageGun || evt.DamageReason != DamageReasonHeadShot {
+		t.Errorf("unexpected event %+v", evt)
+	}
+	if telemetry.MatchID != "match" {
+		t.Errorf("expected match ID to be set, got %q", telemetry.MatchID)
+	}
+}
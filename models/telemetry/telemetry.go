@@ -3,12 +3,11 @@ package telemetry
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
-	"io/ioutil"
-	"reflect"
 	"time"
 
+	"github.com/driquet/gopubg/models/dictionary"
+	"github.com/driquet/gopubg/models/maps"
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,6 +23,16 @@ func findIndex(key string, possibleKeys []string) int {
 	return -1
 }
 
+// enumIndex returns the index of a JSON string among the known values of an
+// enum, or -1 if the value is unknown or is not a string
+func enumIndex(data []byte, possibleKeys []string) int {
+	var key *string
+	if err := json.Unmarshal(data, &key); err != nil || key == nil {
+		return -1
+	}
+	return findIndex(*key, possibleKeys)
+}
+
 // Telemetry event types
 const (
 	PlayerLogin TelemetryEventType = iota
@@ -49,6 +58,37 @@ const (
 	GameStatePeriodic
 	CarePackageSpawn
 	CarePackageLand
+	PlayerMakeGroggy
+	PlayerRevive
+	ArmorDestroy
+	ParachuteLanding
+	SwimStart
+	SwimEnd
+	Heal
+	PhaseChange
+	ObjectDestroy
+	WheelDestroy
+	VaultStart
+	RedZoneEnded
+	ItemPickupFromCarepackage
+	ItemPickupFromLootBox
+	PlayerUseThrowable
+	WeaponFireCount
+	CharacterCarry
+	PlayerKillV2
+	ObjectInteraction
+	VehicleDamage
+	BlackZoneEnded
+	ItemPutToVehicleTrunk
+	ItemPickupFromVehicleTrunk
+	PlayerDestroyProp
+	PlayerDestroyBreachableWall
+	EmPickupLiftOff
+	PlayerUseFlareGun
+
+	// UnknownEvent is the type of events that are not supported, see
+	// TelemetryEvent.RawType
+	UnknownEvent TelemetryEventType = -1
 )
 
 // KnownEventTypes represents supported types
@@ -76,18 +116,55 @@ var KnownEventTypes = []string{
 	"LogGameStatePeriodic",
 	"LogCarePackageSpawn",
 	"LogCarePackageLand",
+	"LogPlayerMakeGroggy",
+	"LogPlayerRevive",
+	"LogArmorDestroy",
+	"LogParachuteLanding",
+	"LogSwimStart",
+	"LogSwimEnd",
+	"LogHeal",
+	"LogPhaseChange",
+	"LogObjectDestroy",
+	"LogWheelDestroy",
+	"LogVaultStart",
+	"LogRedZoneEnded",
+	"LogItemPickupFromCarepackage",
+	"LogItemPickupFromLootBox",
+	"LogPlayerUseThrowable",
+	"LogWeaponFireCount",
+	"LogCharacterCarry",
+	"LogPlayerKillV2",
+	"LogObjectInteraction",
+	"LogVehicleDamage",
+	"LogBlackZoneEnded",
+	"LogItemPutToVehicleTrunk",
+	"LogItemPickupFromVehicleTrunk",
+	"LogPlayerDestroyProp",
+	"LogPlayerDestroyBreachableWall",
+	"LogEmPickupLiftOff",
+	"LogPlayerUseFlareGun",
+}
+
+// String returns the name of the event type as sent by the API, such as
+// LogPlayerKill. UnknownEvent and other unknown types print as "Unknown".
+func (t TelemetryEventType) String() string {
+	if t < 0 || int(t) >= len(KnownEventTypes) {
+		return "Unknown"
+	}
+	return KnownEventTypes[t]
 }
 
-// UnmarshalJSON verifies the type of a telemetry event is known
+// UnmarshalJSON converts the type of a telemetry event, unknown types are
+// converted to UnknownEvent
 func (t *TelemetryEventType) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		return errors.New("TelemetryEventType: UnmarshalJSON on nil pointer")
 	}
 
-	key := string(data)
-	idx := findIndex(key[1:len(key)-1], KnownEventTypes)
+	idx := enumIndex(data, KnownEventTypes)
 	if idx == -1 {
-		return fmt.Errorf("TelemetryEventType: Unknown type %s", key)
+		*t = UnknownEvent
+		return nil
 	}
 
 	*t = TelemetryEventType(idx)
@@ -101,6 +178,8 @@ type TelemetryAttackType int
 const (
 	AttackTypeRedZone TelemetryAttackType = iota
 	AttackTypeWeapon
+
+	AttackTypeUnknown TelemetryAttackType = -1
 )
 
 var knownAttackTypes = []string{
@@ -108,16 +187,17 @@ var knownAttackTypes = []string{
 	"Weapon",
 }
 
-// UnmarshalJSON verifies the type of an attack is known
+// UnmarshalJSON converts the type of an attack, unknown types are converted
+// to AttackTypeUnknown
 func (t *TelemetryAttackType) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		return errors.New("TelemetryAttackType: UnmarshalJSON on nil pointer")
 	}
 
-	key := string(data)
-	idx := findIndex(key[1:len(key)-1], knownAttackTypes)
+	idx := enumIndex(data, knownAttackTypes)
 	if idx == -1 {
-		return fmt.Errorf("TelemetryAttackType: Unknown type %s", key)
+		*t = AttackTypeUnknown
+		return nil
 	}
 
 	*t = TelemetryAttackType(idx)
@@ -142,6 +222,8 @@ const (
 	SubCategoryJacket
 	SubCategoryNone
 	SubCategoryEmpty
+
+	SubCategoryUnknown TelemetrySubCategory = -1
 )
 
 var knownSubCategories = []string{
@@ -160,16 +242,17 @@ var knownSubCategories = []string{
 	"",
 }
 
-// UnmarshalJSON verifies the type of a subcategory
+// UnmarshalJSON converts a subcategory, unknown subcategories are converted to
+// SubCategoryUnknown
 func (t *TelemetrySubCategory) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		return errors.New("TelemetrySubCategory: UnmarshalJSON on nil pointer")
 	}
 
-	key := string(data)
-	idx := findIndex(key[1:len(key)-1], knownSubCategories)
+	idx := enumIndex(data, knownSubCategories)
 	if idx == -1 {
-		return fmt.Errorf("TelemetrySubCategory: Unknown type %s", key)
+		*t = SubCategoryUnknown
+		return nil
 	}
 
 	*t = TelemetrySubCategory(idx)
@@ -194,6 +277,31 @@ const (
 	DamageVehicleCrashHit
 	DamageVehicleHit
 	DamageEmpty
+	DamageBlueZoneGrenade
+	DamageExplosionAircraft
+	DamageExplosionBreach
+	DamageExplosionC4
+	DamageExplosionJerryCan
+	DamageExplosionLootTruck
+	DamageExplosionPanzerFaustBackblast
+	DamageExplosionPanzerFaustWarhead
+	DamageExplosionStickyBomb
+	DamageGunPenetrateBRDM
+	DamagePunch
+	DamageExplosionBlackZone
+	DamageExplosionGasPump
+	DamageExplosionMortar
+	DamageHelicopterHit
+	DamageKillTruckHit
+	DamageLava
+	DamageMeleeThrow
+	DamageMonster
+	DamageMotorGlider
+	DamageNone
+	DamageTrainHit
+	DamageSpikeTrap
+
+	DamageUnknown TelemetryDamageType = -1
 )
 
 var knownDamageTypes = []string{
@@ -210,18 +318,42 @@ var knownDamageTypes = []string{
 	"Damage_VehicleCrashHit",
 	"Damage_VehicleHit",
 	"",
-}
-
-// UnmarshalJSON verifies the type of a subcategory
+	"Damage_BlueZoneGrenade",
+	"Damage_Explosion_Aircraft",
+	"Damage_Explosion_Breach",
+	"Damage_Explosion_C4",
+	"Damage_Explosion_JerryCan",
+	"Damage_Explosion_LootTruck",
+	"Damage_Explosion_PanzerFaustBackblast",
+	"Damage_Explosion_PanzerFaustWarhead",
+	"Damage_Explosion_StickyBomb",
+	"Damage_Gun_Penetrate_BRDM",
+	"Damage_Punch",
+	"Damage_Explosion_BlackZone",
+	"Damage_Explosion_GasPump",
+	"Damage_Explosion_Mortar",
+	"Damage_HelicopterHit",
+	"Damage_KillTruckHit",
+	"Damage_Lava",
+	"Damage_MeleeThrow",
+	"Damage_Monster",
+	"Damage_MotorGlider",
+	"Damage_None",
+	"Damage_TrainHit",
+	"SpikeTrap",
+}
+
+// UnmarshalJSON converts a type of damage, unknown types are converted to
+// DamageUnknown
 func (t *TelemetryDamageType) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		return errors.New("TelemetryDamageType: UnmarshalJSON on nil pointer")
 	}
 
-	key := string(data)
-	idx := findIndex(key[1:len(key)-1], knownDamageTypes)
+	idx := enumIndex(data, knownDamageTypes)
 	if idx == -1 {
-		return fmt.Errorf("TelemetryDamageType: Unknown type %s", key)
+		*t = DamageUnknown
+		return nil
 	}
 
 	*t = TelemetryDamageType(idx)
@@ -240,6 +372,11 @@ const (
 	DamageReasonTorsoShot
 	DamageReasonNonSpecific
 	DamageReasonNone
+	// DamageReasonSimlateAIBeKilled is the reason of the damage killing a bot,
+	// spelled as sent by the API
+	DamageReasonSimlateAIBeKilled
+
+	DamageReasonUnknown TelemetryDamageReason = -1
 )
 
 var knownDamageReasons = []string{
@@ -250,31 +387,35 @@ var knownDamageReasons = []string{
 	"TorsoShot",
 	"NonSpecific",
 	"None",
+	"SimlateAIBeKilled",
 }
 
-// UnmarshalJSON verifies the type of a subcategory
+// UnmarshalJSON converts a reason of damage, unknown reasons are converted to
+// DamageReasonUnknown
 func (t *TelemetryDamageReason) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		return errors.New("TelemetryDamageReason: UnmarshalJSON on nil pointer")
 	}
 
-	key := string(data)
-	idx := findIndex(key[1:len(key)-1], knownDamageReasons)
+	idx := enumIndex(data, knownDamageReasons)
 	if idx == -1 {
-		return fmt.Errorf("TelemetryDamageReason: Unknown type %s", key)
+		*t = DamageReasonUnknown
+		return nil
 	}
 
 	*t = TelemetryDamageReason(idx)
 	return nil
 }
 
-// TelemetryEvent represents any event from a telemetry file
+// TelemetryEvent represents any event from a telemetry file, it holds the
+// fields of every type of event. See Event for a typed representation.
 type TelemetryEvent struct {
 	// Common fields
 	Version   int                `json:"_V"`
 	Timestamp time.Time          `json:"_D"`
 	Type      TelemetryEventType `json:"_T"`
 	U         bool               `json:"_U"`
+	RawType   string             `json:"-"`
 
 	// --- Player
 	// Events: LogPlayerLogin, LogPlayerLogout, LogPlayerCreate, LogPlayerPosition, LogPlayerAttack, LogPlayerTakeDamage, LogPlayerKill
@@ -297,30 +438,81 @@ type TelemetryEvent struct {
 	Killer             *TelemetryCharacter   `json:"killer"`
 	Distance           float64               `json:"distance"`
 
+	// --- Knock down and kill
+	// Events: LogPlayerMakeGroggy, LogPlayerRevive, LogArmorDestroy, LogPlayerKill, LogPlayerKillV2
+	DBNOID                     int                  `json:"dBNOId"`
+	DamageCauserAdditionalInfo []string             `json:"damageCauserAdditionalInfo"`
+	VictimWeapon               string               `json:"victimWeapon"`
+	VictimWeaponAdditionalInfo []string             `json:"victimWeaponAdditionalInfo"`
+	IsAttackerInVehicle        bool                 `json:"isAttackerInVehicle"`
+	IsThroughPenetrableWall    bool                 `json:"isThroughPenetrableWall"`
+	Reviver                    *TelemetryCharacter  `json:"reviver"`
+	VictimGameResult           *TelemetryGameResult `json:"victimGameResult"`
+	DBNOMaker                  *TelemetryCharacter  `json:"dBNOMaker"`
+	DBNODamageInfo             *TelemetryDamageInfo `json:"dBNODamageInfo"`
+	Finisher                   *TelemetryCharacter  `json:"finisher"`
+	FinishDamageInfo           *TelemetryDamageInfo `json:"finishDamageInfo"`
+	KillerDamageInfo           *TelemetryDamageInfo `json:"killerDamageInfo"`
+	AssistsAccountID           []string             `json:"assists_AccountId"`
+	TeamKillersAccountID       []string             `json:"teamKillers_AccountId"`
+	IsSuicide                  bool                 `json:"isSuicide"`
+
+	// --- Movement and actions
+	// Events: LogParachuteLanding, LogSwimStart, LogSwimEnd, LogHeal, LogVaultStart, LogPlayerUseThrowable, LogWeaponFireCount, LogCharacterCarry, LogPlayerUseFlareGun, LogEmPickupLiftOff
+	SwimDistance         float64               `json:"swimDistance"`
+	MaxSwimDepthOfWater  float64               `json:"maxSwimDepthOfWater"`
+	HealAmount           float64               `json:"healAmount"`
+	IsLedgeGrab          bool                  `json:"isLedgeGrab"`
+	FireWeaponStackCount int                   `json:"fireWeaponStackCount"`
+	WeaponID             string                `json:"weaponId"`
+	FireCount            int                   `json:"fireCount"`
+	CarryState           string                `json:"carryState"`
+	Instigator           *TelemetryCharacter   `json:"instigator"`
+	Riders               []*TelemetryCharacter `json:"riders"`
+
 	// --- Vehicle
-	// Events: LogVehicleRide, LogVehicleLeave, VehicleDestroy
+	// Events: LogVehicleRide, LogVehicleLeave, VehicleDestroy, LogWheelDestroy, LogVehicleDamage
 	// Character already defined
 	// Vehicle already defined
 
 	// --- Item
-	// Events: LogItemPickup, LogItemEquip, LogItemUnequip, LogItemAttach, LogItemDrop, LogItemDetach, LogItemUse
-	Item       *TelemetryItem `json:"item"`
-	ParentItem *TelemetryItem `json:"parentItem"`
-	ChildItem  *TelemetryItem `json:"childItem"`
+	// Events: LogItemPickup, LogItemEquip, LogItemUnequip, LogItemAttach, LogItemDrop, LogItemDetach, LogItemUse, LogItemPickupFromCarepackage, LogItemPickupFromLootBox, LogItemPutToVehicleTrunk, LogItemPickupFromVehicleTrunk
+	Item                *TelemetryItem `json:"item"`
+	ParentItem          *TelemetryItem `json:"parentItem"`
+	ChildItem           *TelemetryItem `json:"childItem"`
+	CarePackageUniqueID int64          `json:"carePackageUniqueId"`
+	OwnerTeamID         int            `json:"ownerTeamId"`
+	CreatorAccountID    string         `json:"creatorAccountId"`
 
 	// --- Match
 	// Events: LogMatchStart, LogMatchEnd, LogMatchDefinition
-	Characters  []*TelemetryCharacter
-	MatchID     string `json:"matchId"`
-	PingQuality string `json:"pingQuality"`
+	Characters  TelemetryCharacters `json:"characters"`
+	MatchID     string              `json:"matchId"`
+	PingQuality string              `json:"pingQuality"`
+	MapName     string              `json:"mapName"`
 
 	// --- Care package
 	// Events: LogCarePackageSpawn, LogCarePackageLand
 	ItemPackage *TelemetryItemPackage `json:"itemPackage"`
 
 	// --- Game
-	// Events: LogGameStatePeriodic
-	GameState *TelemetryGameState
+	// Events: LogGameStatePeriodic, LogPhaseChange, LogObjectDestroy, LogRedZoneEnded, LogBlackZoneEnded, LogObjectInteraction, LogPlayerDestroyProp, LogPlayerDestroyBreachableWall
+	GameState        *TelemetryGameState
+	Phase            int                   `json:"phase"`
+	ObjectType       string                `json:"objectType"`
+	ObjectTypeStatus string                `json:"objectTypeStatus"`
+	ObjectTypeCount  int                   `json:"objectTypeCount"`
+	ObjectLocation   *TelemetryLocation    `json:"objectLocation"`
+	Drivers          []*TelemetryCharacter `json:"drivers"`
+	Survivors        []*TelemetryCharacter `json:"survivors"`
+
+	unknownValues []UnknownValue
+}
+
+// DamageCauser returns the human readable name of what caused the damage of
+// the event, such as "M416" or "Bluezone"
+func (te *TelemetryEvent) DamageCauser() string {
+	return dictionary.DamageCauserName(te.DamageCauserName)
 }
 
 // TelemetryItemPackage represents an item package
@@ -345,6 +537,46 @@ type TelemetryGameState struct {
 	RedZoneRadius            float64            `json:"redZoneRadius"`
 }
 
+// TelemetryGameResult represents the result of a player at the end of its game
+type TelemetryGameResult struct {
+	Rank       int                       `json:"rank"`
+	GameResult string                    `json:"gameResult"`
+	TeamID     int                       `json:"teamId"`
+	Stats      *TelemetryGameResultStats `json:"stats"`
+	AccountID  string                    `json:"accountId"`
+}
+
+// TelemetryGameResultStats represents the statistics of a player at the end of
+// its game
+type TelemetryGameResultStats struct {
+	KillCount           int     `json:"killCount"`
+	DistanceOnFoot      float64 `json:"distanceOnFoot"`
+	DistanceOnSwim      float64 `json:"distanceOnSwim"`
+	DistanceOnVehicle   float64 `json:"distanceOnVehicle"`
+	DistanceOnParachute float64 `json:"distanceOnParachute"`
+	DistanceOnFreefall  float64 `json:"distanceOnFreefall"`
+}
+
+// TelemetryDamageInfo represents the damage dealt by a player to knock down or
+// kill another one
+type TelemetryDamageInfo struct {
+	DamageReason            TelemetryDamageReason `json:"damageReason"`
+	DamageTypeCategory      TelemetryDamageType   `json:"damageTypeCategory"`
+	DamageCauserName        string                `json:"damageCauserName"`
+	AdditionalInfo          []string              `json:"additionalInfo"`
+	Distance                float64               `json:"distance"`
+	IsThroughPenetrableWall bool                  `json:"isThroughPenetrableWall"`
+
+	rawDamageReason       string
+	rawDamageTypeCategory string
+}
+
+// DamageCauser returns the human readable name of what caused the damage, such
+// as "M416"
+func (d *TelemetryDamageInfo) DamageCauser() string {
+	return dictionary.DamageCauserName(d.DamageCauserName)
+}
+
 // TelemetryVehicle represents a vehicle
 type TelemetryVehicle struct {
 	VehicleType   string  `json:"vehicleType"`
@@ -353,6 +585,11 @@ type TelemetryVehicle struct {
 	FuelPercent   float64 `json:"feulPercent"`
 }
 
+// Name returns the human readable name of the vehicle, such as "Dacia"
+func (v *TelemetryVehicle) Name() string {
+	return dictionary.VehicleName(v.VehicleID)
+}
+
 // TelemetryItem represents an item
 type TelemetryItem struct {
 	ItemID        string               `json:"itemId"`
@@ -360,6 +597,13 @@ type TelemetryItem struct {
 	Category      string               `json:"category"`
 	SubCategory   TelemetrySubCategory `json:"subCategory"`
 	AttachedItems []string             `json:"attachedItems"`
+
+	rawSubCategory string
+}
+
+// Name returns the human readable name of the item, such as "M416"
+func (i *TelemetryItem) Name() string {
+	return dictionary.ItemName(i.ItemID)
 }
 
 // TelemetryCharacter represents a character
@@ -372,6 +616,57 @@ type TelemetryCharacter struct {
 	AccountID string             `json:"accountId"`
 }
 
+// TelemetryCharacterWrapper represents a character listed at the start or at
+// the end of the match, along with its equipment
+type TelemetryCharacterWrapper struct {
+	Character           *TelemetryCharacter `json:"character"`
+	PrimaryWeaponFirst  string              `json:"primaryWeaponFirst"`
+	PrimaryWeaponSecond string              `json:"primaryWeaponSecond"`
+	SecondaryWeapon     string              `json:"secondaryWeapon"`
+	SpawnKitIndex       int                 `json:"spawnKitIndex"`
+}
+
+// UnmarshalJSON decodes a wrapped character. Older telemetry files list bare
+// characters, which are decoded as wrappers without equipment.
+func (w *TelemetryCharacterWrapper) UnmarshalJSON(data []byte) error {
+	type wrapper TelemetryCharacterWrapper
+	if err := json.Unmarshal(data, (*wrapper)(w)); err != nil {
+		return err
+	}
+	if w.Character != nil {
+		return nil
+	}
+
+	var character TelemetryCharacter
+	if err := json.Unmarshal(data, &character); err != nil {
+		return err
+	}
+	w.Character = &character
+	return nil
+}
+
+// TelemetryCharacters represents the characters listed at the start or at the
+// end of the match, whether the telemetry file lists them bare or wrapped, see
+// TelemetryCharacterWrapper
+type TelemetryCharacters []*TelemetryCharacter
+
+// UnmarshalJSON decodes a list of bare or wrapped characters
+func (c *TelemetryCharacters) UnmarshalJSON(data []byte) error {
+	var wrappers []*TelemetryCharacterWrapper
+	if err := json.Unmarshal(data, &wrappers); err != nil {
+		return err
+	}
+
+	characters := make(TelemetryCharacters, 0, len(wrappers))
+	for _, wrapper := range wrappers {
+		if wrapper != nil && wrapper.Character != nil {
+			characters = append(characters, wrapper.Character)
+		}
+	}
+	*c = characters
+	return nil
+}
+
 // TelemetryLocation represents a location
 type TelemetryLocation struct {
 	X float64 `json:"X"`
@@ -379,6 +674,12 @@ type TelemetryLocation struct {
 	Z float64 `json:"Z"`
 }
 
+// Normalize converts the location to a position relative to the size of a
+// map, see maps.Map.Normalize. A nil map yields (0, 0).
+func (l *TelemetryLocation) Normalize(m *maps.Map) (float64, float64) {
+	return m.Normalize(l.X, l.Y)
+}
+
 // Player represents a player
 type Player struct {
 	Name      string
@@ -400,26 +701,103 @@ func newPlayer(name, accountID string) *Player {
 	}
 }
 
-// Telemetry represents the context of a telemetry file
+// Handler is called with each telemetry event of the type it is registered
+// for, see Telemetry.RegisterHandler
+type Handler func(te *TelemetryEvent)
+
+// EventHandler is called with the typed form of each telemetry event of the
+// type it is registered for, see Telemetry.RegisterEventHandler
+type EventHandler func(evt Event)
+
+// Telemetry represents the context of a telemetry file. The zero value has no
+// handler registered, and only calls the ones registered by the caller; use
+// NewTelemetry to aggregate the events per player.
 type Telemetry struct {
 	Events       []*TelemetryEvent
 	Players      map[string]*Player
 	MatchStarted bool
 	PingQuality  string
 	MatchID      string
+	MapName      string
+
+	// DiscardEvents prevents the events from being kept in Events and in the
+	// Events of the players, for callers only interested in their handlers
+	DiscardEvents bool
+
+	handlers      map[TelemetryEventType][]Handler
+	eventHandlers map[TelemetryEventType][]EventHandler
+}
+
+// NewTelemetry returns an empty telemetry, with the handlers aggregating the
+// events per player already registered
+func NewTelemetry() *Telemetry {
+	t := &Telemetry{
+		Events:        make([]*TelemetryEvent, 0),
+		Players:       make(map[string]*Player),
+		MatchStarted:  false,
+		PingQuality:   "",
+		MatchID:       "",
+		MapName:       "",
+		handlers:      make(map[TelemetryEventType][]Handler),
+		eventHandlers: make(map[TelemetryEventType][]EventHandler),
+	}
+
+	t.RegisterHandler(MatchDefinition, t.ProcessLogMatchDefinition)
+	t.RegisterHandler(MatchStart, t.ProcessLogMatchStart)
+	t.RegisterHandler(MatchEnd, t.ProcessLogMatchEnd)
+	t.RegisterHandler(PlayerCreate, t.ProcessLogPlayerCreate)
+	t.RegisterHandler(PlayerAttack, t.ProcessLogPlayerAttack)
+	t.RegisterHandler(PlayerTakeDamage, t.ProcessLogPlayerTakeDamage)
+	t.RegisterHandler(VehicleDestroy, t.ProcessLogVehicleDestroy)
+	t.RegisterHandler(PlayerMakeGroggy, t.ProcessLogPlayerMakeGroggy)
+	t.RegisterHandler(PlayerRevive, t.ProcessLogPlayerRevive)
+	t.RegisterHandler(ArmorDestroy, t.ProcessLogArmorDestroy)
+	t.RegisterHandler(WheelDestroy, t.ProcessLogWheelDestroy)
+	t.RegisterHandler(PlayerUseThrowable, t.ProcessLogPlayerUseThrowable)
+	t.RegisterHandler(RedZoneEnded, t.ProcessLogRedZoneEnded)
+	t.RegisterHandler(PlayerKillV2, t.ProcessLogPlayerKillV2)
+	t.RegisterHandler(VehicleDamage, t.ProcessLogVehicleDamage)
+	t.RegisterHandler(BlackZoneEnded, t.ProcessLogBlackZoneEnded)
+	t.RegisterHandler(PlayerDestroyProp, t.ProcessLogPlayerDestroyProp)
+	t.RegisterHandler(PlayerDestroyBreachableWall, t.ProcessLogPlayerDestroyBreachableWall)
+	t.RegisterHandler(EmPickupLiftOff, t.ProcessLogEmPickupLiftOff)
+	t.RegisterHandler(PlayerUseFlareGun, t.ProcessLogPlayerUseFlareGun)
+
+	return t
+}
+
+// RegisterHandler registers a handler called with each event of the given
+// type. Handlers of a type are called in the order they were registered,
+// after the built-in ones. Events of unsupported types are handled by the
+// handlers of UnknownEvent.
+func (t *Telemetry) RegisterHandler(eventType TelemetryEventType, handler Handler) {
+	if t.handlers == nil {
+		t.handlers = make(map[TelemetryEventType][]Handler)
+	}
+	t.handlers[eventType] = append(t.handlers[eventType], handler)
 }
 
-func newTelemetry() *Telemetry {
-	return &Telemetry{
-		Events:       make([]*TelemetryEvent, 0),
-		Players:      make(map[string]*Player),
-		MatchStarted: false,
-		PingQuality:  "",
-		MatchID:      "",
+// RegisterEventHandler registers a handler called with the typed form of each
+// event of the given type, such as *LogPlayerKill for PlayerKill. Events of
+// unsupported types are handled as *RawEvent by the handlers of UnknownEvent.
+// Typed handlers are called by Decode, after the handlers registered with
+// RegisterHandler.
+func (t *Telemetry) RegisterEventHandler(eventType TelemetryEventType, handler EventHandler) {
+	if t.eventHandlers == nil {
+		t.eventHandlers = make(map[TelemetryEventType][]EventHandler)
 	}
+	t.eventHandlers[eventType] = append(t.eventHandlers[eventType], handler)
+}
+
+// Map returns the map the match was played on, or nil if the map is unknown
+func (t *Telemetry) Map() *maps.Map {
+	return maps.Lookup(t.MapName)
 }
 
 func (t *Telemetry) getPlayer(name, accountID string) *Player {
+	if t.Players == nil {
+		t.Players = make(map[string]*Player)
+	}
 	if _, ok := t.Players[accountID]; !ok {
 		t.Players[accountID] = newPlayer(name, accountID)
 	}
@@ -428,21 +806,25 @@ func (t *Telemetry) getPlayer(name, accountID string) *Player {
 }
 
 func (t *Telemetry) addPlayerEvent(te *TelemetryEvent, character *TelemetryCharacter, matchStarted bool) {
-	if character.Name == "" {
+	if character == nil || character.Name == "" {
 		return
 	}
 
 	player := t.getPlayer(character.Name, character.AccountID)
 
 	if matchStarted {
-		player.Events = append(player.Events, te)
+		if !t.DiscardEvents {
+			player.Events = append(player.Events, te)
+		}
 		player.Locations = append(player.Locations, character.Location)
 	}
 }
 
-func (t *Telemetry) processEvent(te *TelemetryEvent) {
+// ProcessEvent calls the handlers registered for the type of the event with
+// RegisterHandler. It does not add the event to the Events of the telemetry.
+func (t *Telemetry) ProcessEvent(te *TelemetryEvent) {
 	logrus.WithFields(logrus.Fields{
-		"type": KnownEventTypes[te.Type],
+		"type": te.RawType,
 	}).Debug("Processing event")
 
 	// Look for common fields
@@ -450,16 +832,34 @@ func (t *Telemetry) processEvent(te *TelemetryEvent) {
 		t.addPlayerEvent(te, te.Character, t.MatchStarted)
 	}
 
-	// Look for a custom function to specialize the data
-	functionName := "Process" + KnownEventTypes[te.Type]
-	f := reflect.ValueOf(t).MethodByName(functionName)
-	if f.IsValid() {
-		f.Call([]reflect.Value{
-			reflect.ValueOf(te),
-		})
+	for _, handler := range t.handlers[te.Type] {
+		handler(te)
 	}
 }
 
+// Decode reads the events of the decoder, adding them to the Events of the
+// telemetry unless DiscardEvents is set, and calling their handlers
+func (t *Telemetry) Decode(dec *Decoder) error {
+	for dec.Next() {
+		e := dec.Event()
+		if !t.DiscardEvents {
+			t.Events = append(t.Events, e)
+		}
+		t.ProcessEvent(e)
+
+		if handlers := t.eventHandlers[e.Type]; len(handlers) > 0 {
+			evt, err := dec.TypedEvent()
+			if err != nil {
+				return err
+			}
+			for _, handler := range handlers {
+				handler(evt)
+			}
+		}
+	}
+	return dec.Err()
+}
+
 // ProcessLogMatchDefinition deals with event of type MatchDefinition
 func (t *Telemetry) ProcessLogMatchDefinition(te *TelemetryEvent) {
 	t.PingQuality = te.PingQuality
@@ -469,12 +869,16 @@ func (t *Telemetry) ProcessLogMatchDefinition(te *TelemetryEvent) {
 // ProcessLogMatchStart deals with event of type MatchStart
 func (t *Telemetry) ProcessLogMatchStart(te *TelemetryEvent) {
 	t.MatchStarted = true
+	t.MapName = te.MapName
 }
 
 // ProcessLogMatchEnd deals with event of type MatchEnd
 func (t *Telemetry) ProcessLogMatchEnd(te *TelemetryEvent) {
 	// Update player ranking
 	for _, c := range te.Characters {
+		if c.AccountID == "" {
+			continue
+		}
 		player := t.getPlayer(c.Name, c.AccountID)
 		player.Ranking = c.Ranking
 	}
@@ -502,24 +906,108 @@ func (t *Telemetry) ProcessLogVehicleDestroy(te *TelemetryEvent) {
 	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
 }
 
-// ParseTelemetry parses a json response containing telemetry information
-func ParseTelemetry(in io.Reader) (*Telemetry, error) {
-	t := newTelemetry()
+// ProcessLogPlayerMakeGroggy deals with event of type PlayerMakeGroggy
+func (t *Telemetry) ProcessLogPlayerMakeGroggy(te *TelemetryEvent) {
+	t.addPlayersEvent(te, te.Attacker, te.Victim)
+}
 
-	data, err := ioutil.ReadAll(in)
-	if err != nil {
-		return nil, err
-	}
+// ProcessLogPlayerRevive deals with event of type PlayerRevive
+func (t *Telemetry) ProcessLogPlayerRevive(te *TelemetryEvent) {
+	t.addPlayersEvent(te, te.Reviver, te.Victim)
+}
+
+// ProcessLogArmorDestroy deals with event of type ArmorDestroy
+func (t *Telemetry) ProcessLogArmorDestroy(te *TelemetryEvent) {
+	t.addPlayersEvent(te, te.Attacker, te.Victim)
+}
+
+// ProcessLogWheelDestroy deals with event of type WheelDestroy
+func (t *Telemetry) ProcessLogWheelDestroy(te *TelemetryEvent) {
+	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
+}
+
+// ProcessLogPlayerUseThrowable deals with event of type PlayerUseThrowable
+func (t *Telemetry) ProcessLogPlayerUseThrowable(te *TelemetryEvent) {
+	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
+}
+
+// ProcessLogRedZoneEnded deals with event of type RedZoneEnded
+func (t *Telemetry) ProcessLogRedZoneEnded(te *TelemetryEvent) {
+	t.addPlayersEvent(te, te.Drivers...)
+}
 
-	// Parse events
-	if err := json.Unmarshal(data, &t.Events); err != nil {
-		return nil, err
+// ProcessLogPlayerKillV2 deals with event of type PlayerKillV2
+func (t *Telemetry) ProcessLogPlayerKillV2(te *TelemetryEvent) {
+	t.addPlayersEvent(te, te.Killer, te.Finisher, te.DBNOMaker, te.Victim)
+}
+
+// ProcessLogVehicleDamage deals with event of type VehicleDamage
+func (t *Telemetry) ProcessLogVehicleDamage(te *TelemetryEvent) {
+	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
+}
+
+// ProcessLogBlackZoneEnded deals with event of type BlackZoneEnded
+func (t *Telemetry) ProcessLogBlackZoneEnded(te *TelemetryEvent) {
+	t.addPlayersEvent(te, te.Survivors...)
+}
+
+// ProcessLogPlayerDestroyProp deals with event of type PlayerDestroyProp
+func (t *Telemetry) ProcessLogPlayerDestroyProp(te *TelemetryEvent) {
+	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
+}
+
+// ProcessLogPlayerDestroyBreachableWall deals with event of type
+// PlayerDestroyBreachableWall
+func (t *Telemetry) ProcessLogPlayerDestroyBreachableWall(te *TelemetryEvent) {
+	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
+}
+
+// ProcessLogEmPickupLiftOff deals with event of type EmPickupLiftOff
+func (t *Telemetry) ProcessLogEmPickupLiftOff(te *TelemetryEvent) {
+	t.addPlayersEvent(te, append([]*TelemetryCharacter{te.Instigator}, te.Riders...)...)
+}
+
+// ProcessLogPlayerUseFlareGun deals with event of type PlayerUseFlareGun
+func (t *Telemetry) ProcessLogPlayerUseFlareGun(te *TelemetryEvent) {
+	t.addPlayerEvent(te, te.Attacker, t.MatchStarted)
+}
+
+// addPlayersEvent adds an event to several players, once per player even when
+// a player has several roles in the event, such as killer and finisher
+func (t *Telemetry) addPlayersEvent(te *TelemetryEvent, characters ...*TelemetryCharacter) {
+	added := make(map[string]bool)
+	for _, character := range characters {
+		if character == nil || added[character.AccountID] {
+			continue
+		}
+		added[character.AccountID] = true
+		t.addPlayerEvent(te, character, t.MatchStarted)
 	}
+}
+
+// ParseTelemetry parses a json response containing telemetry information. It
+// fails if the telemetry contains unknown event types or values, see
+// ParseTelemetryLenient.
+func ParseTelemetry(in io.Reader) (*Telemetry, error) {
+	t, _, err := parseTelemetry(NewDecoder(in))
+	return t, err
+}
+
+// ParseTelemetryLenient parses a json response containing telemetry
+// information. Events of unknown types are kept with the UnknownEvent type,
+// and unknown values are converted to their Unknown constant; all of them are
+// listed in the returned report.
+func ParseTelemetryLenient(in io.Reader) (*Telemetry, *ParseReport, error) {
+	dec := NewDecoder(in)
+	dec.Lenient = true
+	return parseTelemetry(dec)
+}
 
-	// Find players
-	for _, e := range t.Events {
-		t.processEvent(e)
+func parseTelemetry(dec *Decoder) (*Telemetry, *ParseReport, error) {
+	t := NewTelemetry()
+	if err := t.Decode(dec); err != nil {
+		return nil, nil, err
 	}
 
-	return t, nil
+	return t, dec.Report(), nil
 }
@@ -0,0 +1,212 @@
+package telemetry
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/driquet/gopubg/models/maps"
+)
+
+func TestHandlers(t *testing.T) {
+	input := `[
+		{"_T":"LogMatchDefinition","matchId":"match"},
+		{"_T":"LogMatchStart","mapName":"Desert_Main"},
+		{"_T":"LogHeal","character":{"name":"a","accountId":"account.a"},"healAmount":15},
+		{"_T":"LogPlayerTakeDamage","attacker":null,"victim":{"name":"a","accountId":"account.a"},"damageTypeCategory":"Damage_BlueZone"},
+		{"_T":"LogHeal","character":{"name":"a","accountId":"account.a"},"healAmount":10}
+	]`
+
+	tests := []struct {
+		name          string
+		discardEvents bool
+		events        int
+		playerEvents  int
+	}{
+		{"keep events", false, 5, 3},
+		{"discard events", true, 0, 0},
+	}
+
+	for _, test := range tests {
+		tel := NewTelemetry()
+		tel.DiscardEvents = test.discardEvents
+
+		var calls []string
+		var healed float64
+		tel.RegisterHandler(Heal, func(te *TelemetryEvent) {
+			calls = append(calls, "handler")
+		})
+		tel.RegisterEventHandler(Heal, func(evt Event) {
+			calls = append(calls, "event handler")
+			healed += evt.(*LogHeal).HealAmount
+		})
+
+		if err := tel.Decode(NewDecoder(strings.NewReader(input))); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if strings.Join(calls, ",") != "handler,event handler,handler,event handler" {
+			t.Errorf("%s: unexpected calls %v", test.name, calls)
+		}
+		if healed != 25 {
+			t.Errorf("%s: expected 25 healed, got %v", test.name, healed)
+		}
+		if tel.MapName != "Desert_Main" || tel.MatchID != "match" {
+			t.Errorf("%s: built-in handlers were not called", test.name)
+		}
+		if len(tel.Events) != test.events {
+			t.Errorf("%s: expected %d events, got %d", test.name, test.events, len(tel.Events))
+		}
+
+		player := tel.Players["account.a"]
+		if player == nil {
+			t.Fatalf("%s: player not aggregated", test.name)
+		}
+		if len(player.Events) != test.playerEvents {
+			t.Errorf("%s: expected %d player events, got %d", test.name, test.playerEvents, len(player.Events))
+		}
+	}
+}
+
+func TestMatchEndRanking(t *testing.T) {
+	tests := []struct {
+		name       string
+		characters string
+	}{
+		{"bare characters", `[{"name":"a","accountId":"account.a","ranking":1},{"name":"b","accountId":"account.b","ranking":2}]`},
+		{"wrapped characters", `[{"character":{"name":"a","accountId":"account.a","ranking":1},"primaryWeaponFirst":"WeapHK416_C"},{"character":{"name":"b","accountId":"account.b","ranking":2}}]`},
+	}
+
+	for _, test := range tests {
+		input := `[{"_T":"LogMatchStart","characters":` + test.characters + `},{"_T":"LogMatchEnd","characters":` + test.characters + `}]`
+
+		tel, err := ParseTelemetry(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if len(tel.Players) != 2 {
+			t.Errorf("%s: expected 2 players, got %d", test.name, len(tel.Players))
+		}
+		for accountID, ranking := range map[string]int{"account.a": 1, "account.b": 2} {
+			if player := tel.Players[accountID]; player == nil || player.Ranking != ranking {
+				t.Errorf("%s: expected %s to be ranked %d, got %+v", test.name, accountID, ranking, player)
+			}
+		}
+
+		evt, err := ParseEvent([]byte(`{"_T":"LogMatchEnd","characters":` + test.characters + `}`))
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		characters := evt.(*LogMatchEnd).Characters
+		if len(characters) != 2 || characters[0].Character == nil || characters[0].Character.Name != "a" {
+			t.Errorf("%s: unexpected typed characters %+v", test.name, characters)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	location := &TelemetryLocation{X: 204000, Y: 408000}
+
+	if x, y := location.Normalize(maps.Lookup("Desert_Main")); x != 0.25 || y != 0.5 {
+		t.Errorf("expected (0.25, 0.5), got (%v, %v)", x, y)
+	}
+	if x, y := location.Normalize(nil); x != 0 || y != 0 {
+		t.Errorf("expected (0, 0) on a nil map, got (%v, %v)", x, y)
+	}
+}
+
+func TestZeroValueTelemetry(t *testing.T) {
+	input := `[
+		{"_T":"LogMatchStart","characters":[{"name":"a","accountId":"account.a"}]},
+		{"_T":"LogHeal","character":{"name":"a","accountId":"account.a"},"healAmount":15}
+	]`
+
+	var tel Telemetry
+	var calls int
+	tel.RegisterHandler(Heal, func(te *TelemetryEvent) {
+		calls++
+	})
+	tel.RegisterEventHandler(Heal, func(evt Event) {
+		calls++
+	})
+	tel.RegisterHandler(MatchStart, tel.ProcessLogMatchStart)
+
+	if err := tel.Decode(NewDecoder(strings.NewReader(input))); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+	if len(tel.Events) != 2 {
+		t.Errorf("expected 2 events, got %d", len(tel.Events))
+	}
+	if tel.Players["account.a"] == nil {
+		t.Error("player not aggregated by the registered built-in handler")
+	}
+}
+
+func TestParseModernTelemetry(t *testing.T) {
+	file, err := os.Open(filepath.Join("testdata", "modern.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	// Every event of the file must be known to the strict decoder, and decode
+	// to its typed form
+	dec := NewDecoder(file)
+	types := make(map[TelemetryEventType]int)
+	for dec.Next() {
+		evt, err := dec.TypedEvent()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := evt.(*RawEvent); ok {
+			t.Errorf("event %s has no typed form", dec.Event().RawType)
+		}
+		types[evt.Type()]++
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, eventType := range []TelemetryEventType{
+		ObjectInteraction, VehicleDamage, BlackZoneEnded, ItemPutToVehicleTrunk, ItemPickupFromVehicleTrunk,
+		PlayerDestroyProp, PlayerDestroyBreachableWall, EmPickupLiftOff, PlayerUseFlareGun,
+	} {
+		if types[eventType] != 1 {
+			t.Errorf("expected 1 %s event, got %d", eventType, types[eventType])
+		}
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	tel, err := ParseTelemetry(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tel.MapName != "Tiger_Main" || !strings.HasPrefix(tel.MatchID, "match.bro.official") {
+		t.Errorf("unexpected match %q on %q", tel.MatchID, tel.MapName)
+	}
+	for accountID, ranking := range map[string]int{"account.a1b2": 1, "account.c3d4": 2} {
+		if player := tel.Players[accountID]; player == nil || player.Ranking != ranking {
+			t.Errorf("expected %s to be ranked %d, got %+v", accountID, ranking, player)
+		}
+	}
+
+	// The flare gun, the vehicle damage and the emergency pickup are
+	// aggregated to Alpha along with the events holding its character
+	if events := len(tel.Players["account.a1b2"].Events); events != 7 {
+		t.Errorf("expected 7 events for account.a1b2, got %d", events)
+	}
+
+	kill := tel.Events[len(tel.Events)-2]
+	if kill.FinishDamageInfo.DamageReason != DamageReasonSimlateAIBeKilled || kill.KillerDamageInfo.DamageTypeCategory != DamageTrainHit {
+		t.Errorf("unexpected damage infos %+v %+v", kill.FinishDamageInfo, kill.KillerDamageInfo)
+	}
+}
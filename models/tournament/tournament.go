@@ -0,0 +1,61 @@
+package tournament
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/slemgrim/jsonapi"
+)
+
+// Tournament structure represents an esports tournament
+type Tournament struct {
+	ID        string    `jsonapi:"primary,tournament"`
+	CreatedAt time.Time `jsonapi:"attr,createdAt,iso8601"`
+	Matches   []*Match  `jsonapi:"relation,matches"`
+}
+
+// Match structure represents a match of a tournament
+type Match struct {
+	ID        string    `jsonapi:"primary,match"`
+	CreatedAt time.Time `jsonapi:"attr,createdAt,iso8601"`
+}
+
+// MatchIDs returns the IDs of the matches of the tournament, to be requested
+// from the tournament shard
+func (t *Tournament) MatchIDs() []string {
+	ids := make([]string, len(t.Matches))
+	for idx, match := range t.Matches {
+		ids[idx] = match.ID
+	}
+	return ids
+}
+
+// ParseTournament parses a json response containing a tournament, along with
+// its included matches
+func ParseTournament(in io.Reader) (*Tournament, error) {
+	tournament := new(Tournament)
+	if err := jsonapi.UnmarshalPayload(in, tournament); err != nil {
+		return nil, err
+	}
+	return tournament, nil
+}
+
+// ParseTournaments parses a json response containing tournaments information
+func ParseTournaments(in io.Reader) ([]*Tournament, error) {
+	result, err := jsonapi.UnmarshalManyPayload(in, reflect.TypeOf(new(Tournament)))
+	if err != nil {
+		return nil, err
+	}
+
+	tournaments := make([]*Tournament, len(result))
+	for idx, elt := range result {
+		tournament, ok := elt.(*Tournament)
+		if !ok {
+			return nil, errors.New("Failed to convert tournaments")
+		}
+		tournaments[idx] = tournament
+	}
+	return tournaments, nil
+}
@@ -0,0 +1,68 @@
+package gopubg
+
+import (
+	"net/http"
+	"strings"
+)
+
+// DefaultBaseURL is the base URL of the official PUBG API
+const DefaultBaseURL = "https://api.playbattlegrounds.com"
+
+// Option configures an API instance, see NewAPI
+type Option func(*API)
+
+// WithHTTPClient sets the http client used to execute requests. Sharing a
+// client between API instances allows connections to be reused. A nil client
+// is ignored.
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *API) {
+		if client != nil {
+			a.client = client
+		}
+	}
+}
+
+// WithTransport sets the transport used by the http client, for instance to
+// route requests through a proxy. The transport applies to the client set with
+// WithHTTPClient whatever the order of the options, the client itself is not
+// modified.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(a *API) {
+		a.transport = transport
+	}
+}
+
+// WithBaseURL sets the URL the API endpoints are relative to
+func WithBaseURL(baseURL string) Option {
+	return func(a *API) {
+		a.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(a *API) {
+		a.userAgent = userAgent
+	}
+}
+
+// WithRateLimit sets the number of requests per minute allowed to the API
+// key, until the API reports the actual budget. A limit lower or equal to zero
+// disables the rate limiter.
+func WithRateLimit(limit int) Option {
+	return func(a *API) {
+		if limit <= 0 {
+			a.limiter = nil
+			return
+		}
+		a.limiter = newRateLimiter(limit)
+	}
+}
+
+// WithRetryPolicy sets how requests that failed because of a transient error
+// are retried, see RetryPolicy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(a *API) {
+		a.retryPolicy = policy
+	}
+}
@@ -0,0 +1,37 @@
+package gopubg
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWithTransport(t *testing.T) {
+	transport := &http.Transport{}
+	client := &http.Client{}
+
+	tests := []struct {
+		name    string
+		options []Option
+	}{
+		{"transport only", []Option{WithTransport(transport)}},
+		{"transport then client", []Option{WithTransport(transport), WithHTTPClient(client)}},
+		{"client then transport", []Option{WithHTTPClient(client), WithTransport(transport)}},
+	}
+
+	for _, test := range tests {
+		a := NewAPI("key", test.options...)
+		if a.client.Transport != transport {
+			t.Errorf("%s: transport not applied", test.name)
+		}
+		if client.Transport != nil {
+			t.Errorf("%s: client given to WithHTTPClient was modified", test.name)
+		}
+	}
+}
+
+func TestWithHTTPClientNil(t *testing.T) {
+	a := NewAPI("key", WithHTTPClient(nil))
+	if a.client == nil {
+		t.Fatal("nil client replaced the default one")
+	}
+}
@@ -0,0 +1,144 @@
+package gopubg
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is the number of requests per minute allowed by default to
+// an API key
+const DefaultRateLimit = 10
+
+// rateLimitWindow is the period after which the request budget is refilled
+const rateLimitWindow = time.Minute
+
+// RateLimit represents the request budget of an API key
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// rateLimiter is a token bucket refilled every rateLimitWindow, and seeded
+// from the X-RateLimit headers of the API responses
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func newRateLimiter(limit int) *rateLimiter {
+	return &rateLimiter{
+		limit:     limit,
+		remaining: limit,
+	}
+}
+
+// refill restores the budget once the reset time is reached
+func (r *rateLimiter) refill(now time.Time) {
+	if now.Before(r.reset) {
+		return
+	}
+	r.remaining = r.limit
+	r.reset = now.Add(rateLimitWindow)
+}
+
+// wait blocks until a request can be made, or until the context is done
+func (r *rateLimiter) wait(ctx context.Context) error {
+	for {
+		r.mu.Lock()
+		now := time.Now()
+		r.refill(now)
+		if r.remaining > 0 {
+			r.remaining--
+			r.mu.Unlock()
+			return nil
+		}
+		delay := r.reset.Sub(now)
+		r.mu.Unlock()
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// parseRateLimit reads the X-RateLimit headers of a response. It reports
+// whether the headers were present.
+func parseRateLimit(header http.Header) (RateLimit, bool) {
+	var rateLimit RateLimit
+
+	limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return rateLimit, false
+	}
+	rateLimit.Limit = limit
+	rateLimit.Remaining, _ = strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		rateLimit.Reset = time.Unix(reset, 0)
+	}
+
+	return rateLimit, true
+}
+
+// update seeds the bucket from the X-RateLimit headers of a response
+func (r *rateLimiter) update(header http.Header) {
+	rateLimit, ok := parseRateLimit(header)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rateLimit.Limit > 0 {
+		r.limit = rateLimit.Limit
+	}
+	r.remaining = rateLimit.Remaining
+	if !rateLimit.Reset.IsZero() {
+		r.reset = rateLimit.Reset
+	}
+}
+
+// exhaust empties the budget after the API rejected a request
+func (r *rateLimiter) exhaust(header http.Header) {
+	r.update(header)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.remaining = 0
+	if delay, ok := retryAfter(header); ok && now.Add(delay).After(r.reset) {
+		r.reset = now.Add(delay)
+	}
+	if !r.reset.After(now) {
+		r.reset = now.Add(rateLimitWindow)
+	}
+}
+
+// status returns the current budget
+func (r *rateLimiter) status() RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.refill(time.Now())
+	return RateLimit{
+		Limit:     r.limit,
+		Remaining: r.remaining,
+		Reset:     r.reset,
+	}
+}
+
+// RateLimit returns the current request budget of the API key. The budget is
+// updated after each response of the API.
+func (a *API) RateLimit() RateLimit {
+	if a.limiter == nil {
+		return RateLimit{}
+	}
+	return a.limiter.status()
+}
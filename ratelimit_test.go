@@ -0,0 +1,106 @@
+package gopubg
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func rateLimitHeader(limit, remaining int, reset time.Time) http.Header {
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return header
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		expected RateLimit
+	}{
+		{"no headers", http.Header{}, RateLimit{Limit: 10, Remaining: 10}},
+		{"headers", rateLimitHeader(100, 42, reset), RateLimit{Limit: 100, Remaining: 42, Reset: reset}},
+		{"budget spent", rateLimitHeader(10, 0, reset), RateLimit{Limit: 10, Remaining: 0, Reset: reset}},
+	}
+
+	for _, test := range tests {
+		r := newRateLimiter(10)
+		r.reset = time.Now().Add(time.Minute)
+		if test.expected.Reset.IsZero() {
+			test.expected.Reset = r.reset
+		}
+
+		r.update(test.header)
+		if status := r.status(); status != test.expected {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, status)
+		}
+	}
+}
+
+func TestRateLimiterExhaust(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   http.Header
+		minDelay time.Duration
+		maxDelay time.Duration
+	}{
+		{"no headers", http.Header{}, rateLimitWindow - time.Second, rateLimitWindow},
+		{"retry after", http.Header{"Retry-After": {"30"}}, 29 * time.Second, 30 * time.Second},
+		{"reset", rateLimitHeader(10, 5, time.Now().Add(20*time.Second)), 18 * time.Second, 20 * time.Second},
+	}
+
+	for _, test := range tests {
+		r := newRateLimiter(10)
+		r.exhaust(test.header)
+
+		status := r.status()
+		if status.Remaining != 0 {
+			t.Errorf("%s: expected an empty budget, got %d", test.name, status.Remaining)
+		}
+		if delay := time.Until(status.Reset); delay < test.minDelay || delay > test.maxDelay {
+			t.Errorf("%s: expected a reset in [%s, %s], got %s", test.name, test.minDelay, test.maxDelay, delay)
+		}
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	r := newRateLimiter(2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := r.wait(ctx); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+
+	// The budget is spent, wait blocks until the reset
+	r.mu.Lock()
+	r.reset = time.Now().Add(50 * time.Millisecond)
+	r.mu.Unlock()
+
+	start := time.Now()
+	if err := r.wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("wait returned after %s, before the reset", elapsed)
+	}
+
+	// A cancelled context interrupts the wait
+	r.mu.Lock()
+	r.remaining = 0
+	r.reset = time.Now().Add(time.Hour)
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := r.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
@@ -3,14 +3,112 @@ package gopubg
 import (
 	"bytes"
 	"compress/gzip"
-	"fmt"
+	"context"
 	"io"
 	"net/http"
+	"net/url"
 
+	"github.com/driquet/gopubg/models/pubg"
 	"github.com/sirupsen/logrus"
 )
 
-func httpRequest(url, key string) (*bytes.Buffer, error) {
+// endpointURL builds the URL of an endpoint relative to the base URL
+func (a *API) endpointURL(path string, parameters url.Values) string {
+	endpoint := a.baseURL + path
+	if len(parameters) > 0 {
+		endpoint += "?" + parameters.Encode()
+	}
+	return endpoint
+}
+
+// shardURL builds the URL of an endpoint of a shard, rejecting unknown shards
+// before any request is made
+func (a *API) shardURL(shard pubg.Shard, path string, parameters url.Values) (string, error) {
+	if _, err := pubg.ParseShard(string(shard)); err != nil {
+		return "", err
+	}
+	return a.endpointURL("/shards/"+string(shard)+path, parameters), nil
+}
+
+// do executes a request. Authenticated requests go through the rate limiter:
+// they wait for the request budget to allow them, and are sent again once the
+// budget is refilled when the API replies with 429 Too Many Requests, up to
+// the maximum number of attempts of the retry policy. Other transient failures
+// are retried according to the retry policy.
+func (a *API) do(ctx context.Context, req *http.Request, authenticated bool) (*http.Response, error) {
+	limiter := a.limiter
+	if !authenticated {
+		limiter = nil
+	}
+
+	for attempt := 1; ; {
+		if limiter != nil {
+			if err := limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		response, err := a.client.Do(req)
+		if err != nil && ctx.Err() != nil {
+			// Cancelled requests are not retried
+			return nil, ctx.Err()
+		}
+
+		if err == nil && limiter != nil {
+			if response.StatusCode == http.StatusTooManyRequests {
+				logrus.WithField("reset", response.Header.Get("X-RateLimit-Reset")).Warn("pubg api rate limit exceeded")
+				limiter.exhaust(response.Header)
+				if attempt >= a.retryPolicy.MaxAttempts {
+					return response, nil
+				}
+				response.Body.Close()
+				attempt++
+				continue
+			}
+			limiter.update(response.Header)
+		}
+
+		retry, delay := a.retryPolicy.shouldRetry(attempt, response, err)
+		if !retry {
+			return response, err
+		}
+
+		fields := logrus.Fields{"attempt": attempt, "delay": delay}
+		if err != nil {
+			fields["error"] = err
+		} else {
+			fields["status"] = response.Status
+			response.Body.Close()
+		}
+		logrus.WithFields(fields).Warn("pubg api request failed, retrying")
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		attempt++
+	}
+}
+
+// httpRequest executes a request and reads its whole body
+func (a *API) httpRequest(ctx context.Context, url string, authenticated bool) (*bytes.Buffer, error) {
+	body, err := a.httpStream(ctx, url, authenticated)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	// Reading the body is interrupted as soon as the context is done
+	var buffer bytes.Buffer
+	if _, err := buffer.ReadFrom(body); err != nil {
+		return nil, err
+	}
+
+	return &buffer, nil
+}
+
+// httpStream executes a request and returns its body, decompressed if needed,
+// without reading it. The body must be closed by the caller.
+func (a *API) httpStream(ctx context.Context, url string, authenticated bool) (io.ReadCloser, error) {
 	logrus.WithField("url", url).Info("pubg api request")
 
 	// Create request
@@ -18,40 +116,70 @@ func httpRequest(url, key string) (*bytes.Buffer, error) {
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	// Set request options
-	req.Header.Set("Authorization", key)
+	if authenticated {
+		req.Header.Set("Authorization", a.Key)
+	}
+	if a.userAgent != "" {
+		req.Header.Set("User-Agent", a.userAgent)
+	}
 	req.Header.Set("Accept", "application/vnd.api+json")
 	req.Header.Set("Accept-Encoding", "gzip")
 
 	// Execute request
-	client := &http.Client{}
-	response, err := client.Do(req)
+	response, err := a.do(ctx, req, authenticated)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check http response code
 	if response.StatusCode != 200 {
-		response.Body.Close()
-		return nil, fmt.Errorf("HTTP request failed: %s", response.Status)
+		body, _ := readBody(response)
+		return nil, newAPIError(response, body)
 	}
 
-	// https://api.playbattlegrounds.com/shards/pc-eu?filter[playerNames]=dreuhdreuh
-	// https://api.playbattlegrounds.com/shards/pc-eu/players?filter[playerNames]=dreuhdreuh
+	return responseBody(response)
+}
 
-	// Retrieve response body
-	var reader io.ReadCloser
-	switch response.Header.Get("Content-Encoding") {
-	case "gzip":
-		reader, err = gzip.NewReader(response.Body)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		reader = response.Body
+// gzipBody decompresses the body of a response, and closes it when closed
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
+// responseBody returns the body of a response, decompressed if needed
+func responseBody(response *http.Response) (io.ReadCloser, error) {
+	if response.Header.Get("Content-Encoding") != "gzip" {
+		return response.Body, nil
 	}
-	defer reader.Close()
+
+	gzipReader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+	return &gzipBody{Reader: gzipReader, body: response.Body}, nil
+}
+
+// readBody reads, and decompresses if needed, the body of a response
+func readBody(response *http.Response) (*bytes.Buffer, error) {
+	body, err := responseBody(response)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
 
 	var buffer bytes.Buffer
-	buffer.ReadFrom(reader)
+	if _, err := buffer.ReadFrom(body); err != nil {
+		return nil, err
+	}
 
 	return &buffer, nil
 }
@@ -0,0 +1,190 @@
+package gopubg
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/driquet/gopubg/models/match"
+	"github.com/driquet/gopubg/models/telemetry"
+)
+
+func TestDoRateLimited(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+		rejected    int
+		requests    int
+		rateLimited bool
+	}{
+		{"single attempt", 1, 1, 1, true},
+		{"attempts exhausted", 2, 2, 2, true},
+		{"budget refilled", 2, 1, 2, false},
+	}
+
+	for _, test := range tests {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests <= test.rejected {
+				w.Header().Set("X-RateLimit-Limit", "10")
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Second).Unix(), 10))
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte("{}"))
+		}))
+
+		api := NewAPI("key", WithRetryPolicy(RetryPolicy{MaxAttempts: test.maxAttempts}))
+		_, err := api.httpRequest(context.Background(), server.URL, true)
+		server.Close()
+
+		if IsRateLimited(err) != test.rateLimited {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+		if requests != test.requests {
+			t.Errorf("%s: expected %d requests, got %d", test.name, test.requests, requests)
+		}
+	}
+}
+
+func TestDoRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		requests   int
+		statusCode int
+	}{
+		{"success", []int{http.StatusOK}, 1, http.StatusOK},
+		{"not found is not retried", []int{http.StatusNotFound}, 1, http.StatusNotFound},
+		{"server error then success", []int{http.StatusBadGateway, http.StatusOK}, 2, http.StatusOK},
+		{"server errors", []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK}, 3, http.StatusInternalServerError},
+		{"too many requests then success", []int{http.StatusTooManyRequests, http.StatusOK}, 2, http.StatusOK},
+	}
+
+	for _, test := range tests {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.statuses[requests])
+			requests++
+		}))
+
+		api := NewAPI("key", WithRateLimit(0), WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		response, err := api.do(context.Background(), req, true)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		response.Body.Close()
+		if response.StatusCode != test.statusCode {
+			t.Errorf("%s: expected status %d, got %d", test.name, test.statusCode, response.StatusCode)
+		}
+		if requests != test.requests {
+			t.Errorf("%s: expected %d requests, got %d", test.name, test.requests, requests)
+		}
+	}
+}
+
+func TestDoCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	api := NewAPI("key", WithRateLimit(0), WithRetryPolicy(RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := api.do(ctx, req.WithContext(ctx), true); err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRequestStatusIsNotRateLimited(t *testing.T) {
+	api, server := newTestAPI(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("status request is authenticated")
+		}
+		w.Write([]byte("{}"))
+	})
+	defer server.Close()
+	api.limiter = newRateLimiter(1)
+
+	for i := 0; i < 3; i++ {
+		api.RequestStatus()
+	}
+	if remaining := api.RateLimit().Remaining; remaining != 1 {
+		t.Errorf("status requests spent the request budget, %d remaining", remaining)
+	}
+}
+
+func TestRequestTelemetryDecoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Error("telemetry request is authenticated")
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`[{"_T":"LogMatchDefinition","matchId":"match"},{"_T":"LogMatchStart","mapName":"Desert_Main"}]`))
+		gz.Close()
+	}))
+	defer server.Close()
+
+	api := NewAPI("key", WithRateLimit(0), WithRetryPolicy(NoRetry))
+	m := &match.Match{Assets: []*match.Asset{{Name: "telemetry", URL: server.URL}}}
+
+	dec, body, err := api.RequestTelemetryDecoder(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+
+	types := make([]telemetry.TelemetryEventType, 0)
+	for dec.Next() {
+		types = append(types, dec.Event().Type)
+	}
+	if err := dec.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(types) != 2 || types[0] != telemetry.MatchDefinition || types[1] != telemetry.MatchStart {
+		t.Errorf("unexpected events %v", types)
+	}
+
+	if _, _, err := api.RequestTelemetryDecoder(&match.Match{}); err != ErrNoTelemetry {
+		t.Errorf("expected ErrNoTelemetry, got %v", err)
+	}
+}
+
+func TestRequestTelemetryLenient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"_T":"LogMatchDefinition","matchId":"match"},{"_T":"LogFutureEvent"}]`))
+	}))
+	defer server.Close()
+
+	api := NewAPI("key", WithRateLimit(0), WithRetryPolicy(NoRetry))
+	m := &match.Match{Assets: []*match.Asset{{Name: "telemetry", URL: server.URL}}}
+
+	if _, err := api.RequestTelemetry(m); err == nil {
+		t.Error("expected an error on an unknown event type")
+	}
+
+	tel, report, err := api.RequestTelemetryLenient(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tel.MatchID != "match" || len(tel.Events) != 2 {
+		t.Errorf("unexpected telemetry %+v", tel)
+	}
+	if report.Empty() {
+		t.Error("expected the unknown event type to be reported")
+	}
+}
@@ -0,0 +1,112 @@
+package gopubg
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy defines how requests that failed because of a transient error
+// are retried: network errors, 5xx responses and 429 Too Many Requests
+// responses. When the rate limiter is enabled, 429 responses are retried once
+// the request budget is refilled rather than after the backoff delay.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of a request, a value lower
+	// or equal to one disables retries
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, doubled at each retry
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of an API instance, unless changed
+// with WithRetryPolicy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    30 * time.Second,
+}
+
+// NoRetry is a retry policy making a single attempt per request
+var NoRetry = RetryPolicy{
+	MaxAttempts: 1,
+}
+
+// backoff returns the delay before the given retry, with a random jitter
+// between half and the whole exponential delay
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// shouldRetry tells whether an attempt must be retried, and after which
+// delay
+func (p RetryPolicy) shouldRetry(attempt int, response *http.Response, err error) (bool, time.Duration) {
+	if attempt >= p.MaxAttempts {
+		return false, 0
+	}
+
+	switch {
+	case err != nil:
+		return true, p.backoff(attempt)
+	case response.StatusCode == http.StatusTooManyRequests:
+		if delay, ok := retryAfter(response.Header); ok {
+			return true, delay
+		}
+		return true, p.backoff(attempt)
+	case response.StatusCode >= 500:
+		return true, p.backoff(attempt)
+	}
+
+	return false, 0
+}
+
+// retryAfter reads the delay to wait before sending a request again from the
+// Retry-After header, or from the X-RateLimit-Reset header
+func retryAfter(header http.Header) (time.Duration, bool) {
+	if value := header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return time.Until(date), true
+		}
+	}
+
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		return time.Until(time.Unix(reset, 0)), true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given delay, or until the context is done
+func sleep(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,81 @@
+package gopubg
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	tests := []struct {
+		retry int
+		max   time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{10, 5 * time.Second},
+	}
+
+	for _, test := range tests {
+		for i := 0; i < 100; i++ {
+			delay := policy.backoff(test.retry)
+			if delay < test.max/2 || delay > test.max {
+				t.Fatalf("backoff(%d) = %s, expected in [%s, %s]", test.retry, delay, test.max/2, test.max)
+			}
+		}
+	}
+
+	if delay := (RetryPolicy{}).backoff(1); delay != 0 {
+		t.Errorf("expected no delay without base delay, got %s", delay)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	response := func(statusCode int, header http.Header) *http.Response {
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{StatusCode: statusCode, Header: header}
+	}
+
+	tests := []struct {
+		name     string
+		attempt  int
+		response *http.Response
+		err      error
+		retry    bool
+		delay    time.Duration
+	}{
+		{"success", 1, response(http.StatusOK, nil), nil, false, 0},
+		{"not found", 1, response(http.StatusNotFound, nil), nil, false, 0},
+		{"unauthorized", 1, response(http.StatusUnauthorized, nil), nil, false, 0},
+		{"network error", 1, nil, errors.New("connection reset"), true, time.Second},
+		{"server error", 1, response(http.StatusInternalServerError, nil), nil, true, time.Second},
+		{"bad gateway", 2, response(http.StatusBadGateway, nil), nil, true, 2 * time.Second},
+		{"too many requests", 1, response(http.StatusTooManyRequests, http.Header{"Retry-After": {"7"}}), nil, true, 7 * time.Second},
+		{"last attempt", 3, response(http.StatusInternalServerError, nil), nil, false, 0},
+	}
+
+	for _, test := range tests {
+		retry, delay := policy.shouldRetry(test.attempt, test.response, test.err)
+		if retry != test.retry {
+			t.Errorf("%s: expected retry=%v, got %v", test.name, test.retry, retry)
+		}
+		if delay < test.delay/2 || delay > test.delay {
+			t.Errorf("%s: expected a delay in [%s, %s], got %s", test.name, test.delay/2, test.delay, delay)
+		}
+	}
+}
+
+func TestNoRetry(t *testing.T) {
+	retry, _ := NoRetry.shouldRetry(1, nil, errors.New("connection reset"))
+	if retry {
+		t.Error("NoRetry retried a request")
+	}
+}